@@ -0,0 +1,167 @@
+package ru
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	ut "github.com/go-playground/universal-translator"
+)
+
+// Case is the grammatical case a label is declined in.
+type Case uint8
+
+// Cases a template placeholder may select, e.g. {0:gen}.
+const (
+	Nominative Case = iota
+	Genitive
+	Dative
+	Accusative
+	Instrumental
+	Prepositional
+)
+
+var caseNames = map[string]Case{
+	"nom":  Nominative,
+	"gen":  Genitive,
+	"dat":  Dative,
+	"acc":  Accusative,
+	"ins":  Instrumental,
+	"prep": Prepositional,
+}
+
+// Form returns the label declined in c, falling back to Name when the form
+// is not known.
+func (l Label) Form(c Case) string {
+
+	var form string
+
+	switch c {
+	case Genitive:
+		form = l.Genitive
+	case Dative:
+		form = l.Dative
+	case Accusative:
+		form = l.Accusative
+	case Instrumental:
+		form = l.Instrumental
+	case Prepositional:
+		form = l.Prepositional
+	}
+
+	if form == "" {
+		return l.Name
+	}
+
+	return form
+}
+
+var placeholderRegexp = regexp.MustCompile(`\{(\d+)(?::([a-z]+))?\}`)
+
+// placeholder is a positional parameter of a rewritten template, referring
+// to the original parameter param declined in form.
+type placeholder struct {
+	param int
+	form  Case
+}
+
+// parseTemplate rewrites text containing case selecting placeholders such
+// as {0:gen} into one with the positional placeholders understood by
+// ut.Translator. placeholders is nil when text selects no case.
+func parseTemplate(text string) (rewritten string, placeholders []placeholder, err error) {
+
+	if !strings.Contains(text, ":") {
+		return text, nil, nil
+	}
+
+	matches := placeholderRegexp.FindAllStringSubmatchIndex(text, -1)
+
+	var cased bool
+	for _, m := range matches {
+		if m[4] != -1 {
+			cased = true
+			break
+		}
+	}
+
+	if !cased {
+		return text, nil, nil
+	}
+
+	var b strings.Builder
+	var start int
+
+	for i, m := range matches {
+
+		p := placeholder{}
+		p.param, _ = strconv.Atoi(text[m[2]:m[3]])
+
+		if m[4] != -1 {
+			c, ok := caseNames[text[m[4]:m[5]]]
+			if !ok {
+				return "", nil, fmt.Errorf("unknown case %q in translation %q", text[m[4]:m[5]], text)
+			}
+			p.form = c
+		}
+
+		b.WriteString(text[start:m[0]])
+		b.WriteString("{" + strconv.Itoa(i) + "}")
+		start = m[1]
+
+		placeholders = append(placeholders, p)
+	}
+
+	b.WriteString(text[start:])
+
+	return b.String(), placeholders, nil
+}
+
+// add is ut.Add for templates that may select the case of their labels.
+func (o *options) add(ut ut.Translator, key string, text string, override bool) error {
+
+	text, placeholders, err := parseTemplate(text)
+	if err != nil {
+		return err
+	}
+
+	if err = ut.Add(key, text, override); err != nil {
+		return err
+	}
+
+	o.mu.Lock()
+	if placeholders != nil {
+		o.placeholders[key] = placeholders
+	} else {
+		delete(o.placeholders, key)
+	}
+	o.mu.Unlock()
+
+	return nil
+}
+
+// t is ut.T for templates registered with add.
+func (o *options) t(ut ut.Translator, key string, params ...Label) (string, error) {
+
+	o.mu.RLock()
+	placeholders, ok := o.placeholders[key]
+	o.mu.RUnlock()
+
+	var args []string
+
+	if ok {
+		args = make([]string, len(placeholders))
+		for i, p := range placeholders {
+			if p.param < len(params) {
+				args[i] = params[p.param].Form(p.form)
+			}
+		}
+	} else {
+		args = make([]string, len(params))
+		for i, p := range params {
+			args[i] = p.Name
+		}
+	}
+
+	return ut.T(key, args...)
+}
@@ -0,0 +1,92 @@
+package ru
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+func TestParseTemplate(t *testing.T) {
+
+	tests := []struct {
+		text         string
+		rewritten    string
+		placeholders []placeholder
+	}{
+		{
+			text:      "Поле {0} должно быть равно {1}",
+			rewritten: "Поле {0} должно быть равно {1}",
+		},
+		{
+			text:      "Значение {0:gen} должно быть раньше {1:gen}",
+			rewritten: "Значение {0} должно быть раньше {1}",
+			placeholders: []placeholder{
+				{param: 0, form: Genitive},
+				{param: 1, form: Genitive},
+			},
+		},
+		{
+			text:      "{1:nom} и {0:ins}: {1}",
+			rewritten: "{0} и {1}: {2}",
+			placeholders: []placeholder{
+				{param: 1, form: Nominative},
+				{param: 0, form: Instrumental},
+				{param: 1, form: Nominative},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+
+			rewritten, placeholders, err := parseTemplate(tt.text)
+			if err != nil {
+				t.Fatalf("parseTemplate: %v", err)
+			}
+
+			if rewritten != tt.rewritten {
+				t.Errorf("got %q, want %q", rewritten, tt.rewritten)
+			}
+
+			if !reflect.DeepEqual(placeholders, tt.placeholders) {
+				t.Errorf("got %v, want %v", placeholders, tt.placeholders)
+			}
+		})
+	}
+
+	if _, _, err := parseTemplate("{0:abl}"); err == nil {
+		t.Error("expected an error for an unknown case")
+	}
+}
+
+type schedule struct {
+	StartAt time.Time `ru:"Дата начала,f,gen=Даты начала" validate:"inFuture"`
+	EndAt   time.Time `ru:"Дата окончания,f" validate:"inFuture"`
+}
+
+func TestCaseTemplates(t *testing.T) {
+
+	names := NewFieldDictionary()
+	validate, trans := newLabelledTranslator(t, names, schedule{})
+
+	err := validate.RegisterValidation("inFuture", func(fl validator.FieldLevel) bool {
+		return fl.Field().Interface().(time.Time).After(time.Now())
+	})
+	if err != nil {
+		t.Fatalf("RegisterValidation: %v", err)
+	}
+
+	err = RegisterExtraTranslations(validate, trans, Catalog{
+		{Tag: "inFuture", Translation: "Значение {0:gen} должно быть в будущем"},
+	}, WithFieldNames(names))
+	if err != nil {
+		t.Fatalf("RegisterExtraTranslations: %v", err)
+	}
+
+	expectTranslations(t, validate, trans, schedule{}, map[string]string{
+		"schedule.StartAt": "Значение Даты начала должно быть в будущем",
+		"schedule.EndAt":   "Значение Дата окончания должно быть в будущем",
+	})
+}
@@ -0,0 +1,59 @@
+package ru
+
+import (
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+)
+
+// Translation describes how a single validation tag is translated.
+//
+// When CustomRegisFunc is nil, Translation is registered under Tag with
+// Override; its placeholders may select the case of the label, e.g.
+// "Значение {0:gen} должно быть в будущем". When CustomTransFunc is nil, the
+// message is rendered with the field label, resolved through WithFieldNames,
+// as {0} and the tag parameter as {1}.
+type Translation struct {
+	Tag             string
+	Translation     string
+	Override        bool
+	CustomRegisFunc validator.RegisterTranslationsFunc
+	CustomTransFunc validator.TranslationFunc
+}
+
+// Catalog is a set of translations registered together.
+type Catalog []Translation
+
+// RegisterExtraTranslations registers application specific translations,
+// typically for tags added with validator.RegisterValidation, on top of the
+// ones installed by RegisterDefaultTranslations.
+func RegisterExtraTranslations(v *validator.Validate, trans ut.Translator, entries Catalog, opts ...Option) error {
+	return registerCatalog(v, trans, entries, newOptions(opts))
+}
+
+func registerCatalog(v *validator.Validate, trans ut.Translator, translations Catalog, o *options) (err error) {
+
+	for _, t := range translations {
+
+		if t.CustomTransFunc != nil && t.CustomRegisFunc != nil {
+
+			err = v.RegisterTranslation(t.Tag, trans, t.CustomRegisFunc, t.CustomTransFunc)
+
+		} else if t.CustomTransFunc != nil && t.CustomRegisFunc == nil {
+
+			err = v.RegisterTranslation(t.Tag, trans, o.registrationFunc(t.Tag, t.Translation, t.Override), t.CustomTransFunc)
+
+		} else if t.CustomTransFunc == nil && t.CustomRegisFunc != nil {
+
+			err = v.RegisterTranslation(t.Tag, trans, t.CustomRegisFunc, o.translateFunc)
+
+		} else {
+			err = v.RegisterTranslation(t.Tag, trans, o.registrationFunc(t.Tag, t.Translation, t.Override), o.translateFunc)
+		}
+
+		if err != nil {
+			return
+		}
+	}
+
+	return
+}
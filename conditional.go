@@ -0,0 +1,141 @@
+package ru
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+)
+
+var splitParamsRegexp = regexp.MustCompile(`'[^']*'|\S+`)
+
+// splitParams splits the parameter of a conditional tag such as
+// required_if the way validator does: on spaces, with 'quoted' values
+// kept together.
+func splitParams(param string) []string {
+
+	params := splitParamsRegexp.FindAllString(param, -1)
+	for i := range params {
+		params[i] = strings.ReplaceAll(params[i], "'", "")
+	}
+
+	return params
+}
+
+// joinList joins items as "A", "A и B" or "A, B и C", conj being " и ".
+func joinList(items []string, conj string) string {
+
+	switch len(items) {
+	case 0:
+		return ""
+	case 1:
+		return items[0]
+	default:
+		return strings.Join(items[:len(items)-1], ", ") + conj + items[len(items)-1]
+	}
+}
+
+// valueCondition renders the "Field value" pairs of the parameter of fe with
+// the gendered key, e.g. "Статус равен «active»", joined with conj.
+func (o *options) valueCondition(ut ut.Translator, fe validator.FieldError, key string, conj string) (string, error) {
+
+	params := splitParams(fe.Param())
+	if len(params) == 0 || len(params)%2 != 0 {
+		return "", fmt.Errorf("bad param %q for %s", fe.Param(), fe.Tag())
+	}
+
+	conds := make([]string, 0, len(params)/2)
+
+	for i := 0; i < len(params); i += 2 {
+
+		lbl := o.relatedLabel(fe, params[i])
+
+		c, err := o.t(ut, genderKey(key, lbl.Gender), lbl, Label{Name: params[i+1]})
+		if err != nil {
+			return "", err
+		}
+
+		conds = append(conds, c)
+	}
+
+	return joinList(conds, conj), nil
+}
+
+// presenceCondition renders the fields listed in the parameter of fe with
+// key, or with key-multiple when there are several of them, e.g.
+// "заполнено поле Телефон". Their labels are joined with conj.
+func (o *options) presenceCondition(ut ut.Translator, fe validator.FieldError, key string, conj string) (string, error) {
+
+	params := splitParams(fe.Param())
+	if len(params) == 0 {
+		return "", fmt.Errorf("bad param %q for %s", fe.Param(), fe.Tag())
+	}
+
+	names := make([]string, len(params))
+	for i, param := range params {
+		names[i] = o.relatedLabel(fe, param).Name
+	}
+
+	if len(names) > 1 {
+		key += "-multiple"
+	}
+
+	return o.t(ut, key, Label{Name: joinList(names, conj)})
+}
+
+// conditionalTransFunc returns the translation func of a conditional tag
+// rendering its message, under the variant of the tag chosen by key for the
+// gender of the label, with the label of the field and the condition built
+// by cond.
+func (o *options) conditionalTransFunc(key func(string, Gender) string, cond func(ut ut.Translator, fe validator.FieldError) (string, error)) validator.TranslationFunc {
+
+	return func(ut ut.Translator, fe validator.FieldError) string {
+
+		c, err := cond(ut, fe)
+		if err != nil {
+			return o.fallback(fe, err)
+		}
+
+		lbl := o.label(fe)
+
+		t, err := o.t(ut, key(fe.Tag(), lbl.Gender), lbl, Label{Name: c})
+		if err != nil {
+			return o.fallback(fe, err)
+		}
+
+		return t
+	}
+}
+
+// oneOfTransFunc returns the translation func of required_without and
+// required_without_all asking for the field or one of the fields it depends
+// on, e.g. "Укажите Телефон или Email". When conditional is not nil, it is
+// used instead for parameters listing several fields.
+func (o *options) oneOfTransFunc(conditional validator.TranslationFunc) validator.TranslationFunc {
+
+	return func(ut ut.Translator, fe validator.FieldError) string {
+
+		params := splitParams(fe.Param())
+		if len(params) == 0 {
+			return o.fallback(fe, fmt.Errorf("bad param %q for %s", fe.Param(), fe.Tag()))
+		}
+
+		if conditional != nil && len(params) > 1 {
+			return conditional(ut, fe)
+		}
+
+		names := []string{o.label(fe).Form(Accusative)}
+		for _, param := range params {
+			names = append(names, o.relatedLabel(fe, param).Form(Accusative))
+		}
+
+		t, err := o.t(ut, fe.Tag()+"-one-of", Label{Name: joinList(names, " или ")})
+		if err != nil {
+			return o.fallback(fe, err)
+		}
+
+		return t
+	}
+}
@@ -0,0 +1,187 @@
+package ru
+
+import (
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type order struct {
+	Status   string
+	Kind     string
+	Phone    string
+	Email    string
+	Telegram string
+
+	Reason   string `validate:"required_if=Status active"`
+	Comment  string `validate:"required_if=Status active Kind 'gift card'"`
+	Address  string `validate:"required_unless=Kind digital"`
+	Courier  string `validate:"required_with=Phone"`
+	Manager  string `validate:"required_with=Phone Email"`
+	Invoice  string `validate:"required_with_all=Phone Email"`
+	Contact  string `validate:"required_without=Phone"`
+	Fallback string `validate:"required_without=Phone Email"`
+	Backup   string `validate:"required_without_all=Phone Telegram"`
+}
+
+func TestRequiredConditionalTags(t *testing.T) {
+
+	names := NewFieldDictionary().
+		AddLabel("Status", Label{Name: "Статус", Gender: Masculine}).
+		AddLabel("Kind", Label{Name: "Вид заказа", Gender: Masculine}).
+		AddLabel("Phone", Label{Name: "Телефон", Gender: Masculine}).
+		AddLabel("Reason", Label{Name: "Причина", Gender: Feminine}).
+		AddLabel("Address", Label{Name: "Адрес", Gender: Masculine}).
+		AddLabel("Contact", Label{Name: "Контактное лицо", Gender: Neuter}).
+		AddLabel("Backup", Label{Name: "Резервная почта", Gender: Feminine, Accusative: "Резервную почту"})
+
+	validate := validator.New()
+	trans := NewTranslator()
+
+	if err := RegisterDefaultTranslations(validate, trans, WithFieldNames(names)); err != nil {
+		t.Fatalf("RegisterDefaultTranslations: %v", err)
+	}
+
+	err := validate.Struct(order{Status: "active", Kind: "gift card", Phone: "+7", Email: "a@b.c"})
+
+	errs, ok := err.(validator.ValidationErrors)
+	if !ok {
+		t.Fatalf("expected validation errors, got %v", err)
+	}
+
+	expected := map[string]string{
+		"order.Reason":  "Причина обязательна, если Статус равен «active»",
+		"order.Comment": "Поле Comment обязательно, если Статус равен «active» и Вид заказа равен «gift card»",
+		"order.Address": "Адрес обязателен, если Вид заказа не равен «digital»",
+		"order.Courier": "Поле Courier обязательно, если заполнено поле Телефон",
+		"order.Manager": "Поле Manager обязательно, если заполнено хотя бы одно из полей Телефон, Email",
+		"order.Invoice": "Поле Invoice обязательно, если заполнены поля Телефон и Email",
+	}
+
+	if len(errs) != len(expected) {
+		t.Fatalf("got %d errors, want %d: %v", len(errs), len(expected), errs)
+	}
+
+	for _, fe := range errs {
+		if got := fe.Translate(trans); got != expected[fe.Namespace()] {
+			t.Errorf("%s: got %q, want %q", fe.Namespace(), got, expected[fe.Namespace()])
+		}
+	}
+
+	err = validate.Struct(order{Kind: "digital"})
+
+	errs, ok = err.(validator.ValidationErrors)
+	if !ok {
+		t.Fatalf("expected validation errors, got %v", err)
+	}
+
+	expected = map[string]string{
+		"order.Contact":  "Укажите Контактное лицо или Телефон",
+		"order.Fallback": "Поле Fallback обязательно, если не заполнено хотя бы одно из полей Телефон, Email",
+		"order.Backup":   "Укажите Резервную почту, Телефон или Telegram",
+	}
+
+	if len(errs) != len(expected) {
+		t.Fatalf("got %d errors, want %d: %v", len(errs), len(expected), errs)
+	}
+
+	for _, fe := range errs {
+		if got := fe.Translate(trans); got != expected[fe.Namespace()] {
+			t.Errorf("%s: got %q, want %q", fe.Namespace(), got, expected[fe.Namespace()])
+		}
+	}
+}
+
+func TestJoinList(t *testing.T) {
+
+	tests := []struct {
+		items    []string
+		expected string
+	}{
+		{items: nil, expected: ""},
+		{items: []string{"A"}, expected: "A"},
+		{items: []string{"A", "B"}, expected: "A или B"},
+		{items: []string{"A", "B", "C"}, expected: "A, B или C"},
+	}
+
+	for _, tt := range tests {
+		if got := joinList(tt.items, " или "); got != tt.expected {
+			t.Errorf("got %q, want %q", got, tt.expected)
+		}
+	}
+}
+
+type purchase struct {
+	Status      string
+	Certificate string
+	Card        string
+	Cash        string
+
+	Discount string `validate:"excluded_if=Status paid"`
+	Bonus    string `validate:"excluded_unless=Status new"`
+	Promo    string `validate:"excluded_with=Certificate"`
+	Coupon   string `validate:"excluded_with=Certificate Card"`
+	Voucher  string `validate:"excluded_with_all=Certificate Card"`
+	Tips     string `validate:"excluded_without=Cash"`
+	Change   string `validate:"excluded_without_all=Cash Card"`
+}
+
+func TestExcludedConditionalTags(t *testing.T) {
+
+	names := NewFieldDictionary().
+		AddLabel("Status", Label{Name: "Статус", Gender: Masculine}).
+		AddLabel("Certificate", Label{Name: "Сертификат", Gender: Masculine}).
+		AddLabel("Card", Label{Name: "Карта", Gender: Feminine}).
+		AddLabel("Cash", Label{Name: "Наличные", Gender: Plural}).
+		AddLabel("Promo", Label{Name: "Промокод", Gender: Masculine})
+
+	validate := validator.New()
+	trans := NewTranslator()
+
+	if err := RegisterDefaultTranslations(validate, trans, WithFieldNames(names)); err != nil {
+		t.Fatalf("RegisterDefaultTranslations: %v", err)
+	}
+
+	tests := []struct {
+		value    purchase
+		expected map[string]string
+	}{
+		{
+			value: purchase{Status: "paid", Certificate: "X", Card: "Y", Discount: "1", Bonus: "1", Promo: "1", Coupon: "1", Voucher: "1"},
+			expected: map[string]string{
+				"purchase.Discount": "Поле Discount нельзя заполнять, если Статус равен «paid»",
+				"purchase.Bonus":    "Поле Bonus нельзя заполнять, если Статус не равен «new»",
+				"purchase.Promo":    "Промокод нельзя заполнять, если заполнено поле Сертификат",
+				"purchase.Coupon":   "Поле Coupon нельзя заполнять, если заполнено хотя бы одно из полей Сертификат, Карта",
+				"purchase.Voucher":  "Поле Voucher нельзя заполнять, если заполнены поля Сертификат и Карта",
+			},
+		},
+		{
+			value: purchase{Status: "new", Tips: "1", Change: "1"},
+			expected: map[string]string{
+				"purchase.Tips":   "Поле Tips нельзя заполнять, если не заполнено поле Наличные",
+				"purchase.Change": "Поле Change нельзя заполнять, если не заполнены поля Наличные и Карта",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+
+		err := validate.Struct(tt.value)
+
+		errs, ok := err.(validator.ValidationErrors)
+		if !ok {
+			t.Fatalf("expected validation errors, got %v", err)
+		}
+
+		if len(errs) != len(tt.expected) {
+			t.Fatalf("got %d errors, want %d: %v", len(errs), len(tt.expected), errs)
+		}
+
+		for _, fe := range errs {
+			if got := fe.Translate(trans); got != tt.expected[fe.Namespace()] {
+				t.Errorf("%s: got %q, want %q", fe.Namespace(), got, tt.expected[fe.Namespace()])
+			}
+		}
+	}
+}
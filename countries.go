@@ -0,0 +1,176 @@
+package ru
+
+// countryNames holds the Russian names of the countries validator knows the
+// postcode format of, keyed by their ISO 3166-1 alpha-2 code.
+var countryNames = map[string]string{
+	"AD": "Андорра",
+	"AM": "Армения",
+	"AR": "Аргентина",
+	"AS": "Американское Самоа",
+	"AT": "Австрия",
+	"AU": "Австралия",
+	"AX": "Аландские острова",
+	"AZ": "Азербайджан",
+	"BA": "Босния и Герцеговина",
+	"BB": "Барбадос",
+	"BD": "Бангладеш",
+	"BE": "Бельгия",
+	"BG": "Болгария",
+	"BH": "Бахрейн",
+	"BM": "Бермудские острова",
+	"BN": "Бруней",
+	"BR": "Бразилия",
+	"BY": "Беларусь",
+	"CA": "Канада",
+	"CC": "Кокосовые острова",
+	"CH": "Швейцария",
+	"CK": "Острова Кука",
+	"CL": "Чили",
+	"CN": "Китай",
+	"CR": "Коста-Рика",
+	"CS": "Сербия и Черногория",
+	"CV": "Кабо-Верде",
+	"CX": "Остров Рождества",
+	"CY": "Кипр",
+	"CZ": "Чехия",
+	"DE": "Германия",
+	"DK": "Дания",
+	"DO": "Доминиканская Республика",
+	"DZ": "Алжир",
+	"EC": "Эквадор",
+	"EE": "Эстония",
+	"EG": "Египет",
+	"ES": "Испания",
+	"ET": "Эфиопия",
+	"FI": "Финляндия",
+	"FK": "Фолклендские острова",
+	"FM": "Микронезия",
+	"FO": "Фарерские острова",
+	"FR": "Франция",
+	"GB": "Великобритания",
+	"GE": "Грузия",
+	"GF": "Французская Гвиана",
+	"GG": "Гернси",
+	"GL": "Гренландия",
+	"GN": "Гвинея",
+	"GP": "Гваделупа",
+	"GR": "Греция",
+	"GS": "Южная Георгия и Южные Сандвичевы острова",
+	"GT": "Гватемала",
+	"GU": "Гуам",
+	"GW": "Гвинея-Бисау",
+	"HM": "Остров Херд и острова Макдональд",
+	"HN": "Гондурас",
+	"HR": "Хорватия",
+	"HT": "Гаити",
+	"HU": "Венгрия",
+	"ID": "Индонезия",
+	"IL": "Израиль",
+	"IM": "Остров Мэн",
+	"IN": "Индия",
+	"IO": "Британская территория в Индийском океане",
+	"IQ": "Ирак",
+	"IS": "Исландия",
+	"IT": "Италия",
+	"JE": "Джерси",
+	"JO": "Иордания",
+	"JP": "Япония",
+	"KE": "Кения",
+	"KG": "Киргизия",
+	"KH": "Камбоджа",
+	"KR": "Республика Корея",
+	"KW": "Кувейт",
+	"KZ": "Казахстан",
+	"LA": "Лаос",
+	"LB": "Ливан",
+	"LI": "Лихтенштейн",
+	"LK": "Шри-Ланка",
+	"LR": "Либерия",
+	"LS": "Лесото",
+	"LT": "Литва",
+	"LU": "Люксембург",
+	"LV": "Латвия",
+	"MA": "Марокко",
+	"MC": "Монако",
+	"MD": "Молдова",
+	"ME": "Черногория",
+	"MG": "Мадагаскар",
+	"MH": "Маршалловы Острова",
+	"MK": "Северная Македония",
+	"MN": "Монголия",
+	"MP": "Северные Марианские острова",
+	"MQ": "Мартиника",
+	"MT": "Мальта",
+	"MU": "Маврикий",
+	"MV": "Мальдивы",
+	"MX": "Мексика",
+	"MY": "Малайзия",
+	"NC": "Новая Каледония",
+	"NE": "Нигер",
+	"NF": "Остров Норфолк",
+	"NG": "Нигерия",
+	"NI": "Никарагуа",
+	"NL": "Нидерланды",
+	"NO": "Норвегия",
+	"NP": "Непал",
+	"NZ": "Новая Зеландия",
+	"OM": "Оман",
+	"PF": "Французская Полинезия",
+	"PG": "Папуа — Новая Гвинея",
+	"PH": "Филиппины",
+	"PK": "Пакистан",
+	"PL": "Польша",
+	"PM": "Сен-Пьер и Микелон",
+	"PN": "Острова Питкэрн",
+	"PR": "Пуэрто-Рико",
+	"PT": "Португалия",
+	"PW": "Палау",
+	"PY": "Парагвай",
+	"RE": "Реюньон",
+	"RO": "Румыния",
+	"RS": "Сербия",
+	"RU": "Россия",
+	"SA": "Саудовская Аравия",
+	"SE": "Швеция",
+	"SG": "Сингапур",
+	"SH": "Остров Святой Елены",
+	"SI": "Словения",
+	"SJ": "Шпицберген и Ян-Майен",
+	"SK": "Словакия",
+	"SM": "Сан-Марино",
+	"SN": "Сенегал",
+	"SO": "Сомали",
+	"SZ": "Эсватини",
+	"TC": "Теркс и Кайкос",
+	"TH": "Таиланд",
+	"TJ": "Таджикистан",
+	"TM": "Туркменистан",
+	"TN": "Тунис",
+	"TR": "Турция",
+	"TW": "Тайвань",
+	"UA": "Украина",
+	"US": "США",
+	"UY": "Уругвай",
+	"UZ": "Узбекистан",
+	"VA": "Ватикан",
+	"VE": "Венесуэла",
+	"VI": "Виргинские острова (США)",
+	"VN": "Вьетнам",
+	"WF": "Уоллис и Футуна",
+	"XK": "Косово",
+	"YT": "Майотта",
+	"YU": "Югославия",
+	"ZA": "ЮАР",
+	"ZM": "Замбия",
+}
+
+// countryName returns the Russian name of the country with the ISO 3166-1
+// alpha-2 code, falling back to the code itself.
+func countryName(code string) string {
+
+	if name, ok := countryNames[code]; ok {
+		return name
+	}
+
+	return code
+}
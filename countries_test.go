@@ -0,0 +1,26 @@
+package ru
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCountryNamesCoverPostcodes(t *testing.T) {
+
+	// every country validator has a postcode format for
+	codes := strings.Fields(`GB JE GG IM US CA DE JP FR AU IT CH AT ES NL BE DK SE NO BR PT FI AX KR CN TW SG
+		DZ AD AR AM AZ BH BD BB BY BM BA IO BN BG KH CV CL CR HR CY CZ DO EC EG EE FO GE GR GL GT HT HN HU IS IN
+		ID IL JO KZ KE KW LA LV LB LI LT LU MK MY MV MT MU MX MD MC MA NP NZ NI NG OM PK PY PH PL PR RO RU SM SA
+		SN SK SI ZA LK TJ TH TN TR TM UA UY UZ VA VE ZM AS CC CK RS ME CS YU CX ET FK NF FM GF GN GP GS GU GW HM
+		IQ KG LR LS MG MH MN MP MQ NC NE VI VN PF PG PM PN PW RE SH SJ SO SZ TC WF XK YT`)
+
+	for _, code := range codes {
+		if _, ok := countryNames[code]; !ok {
+			t.Errorf("no name for %s", code)
+		}
+	}
+
+	if got := countryName("QQ"); got != "QQ" {
+		t.Errorf("got %q, want %q", got, "QQ")
+	}
+}
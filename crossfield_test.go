@@ -0,0 +1,84 @@
+package ru
+
+import (
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type interval struct {
+	StartAt int
+	EndAt   int `validate:"gtfield=StartAt"`
+}
+
+type meeting struct {
+	Interval interval
+	Deadline int `validate:"gtcsfield=Interval.StartAt"`
+	Agenda   int `validate:"eqfield=Deadline"`
+	Notes    int `validate:"ltefield=Unknown"`
+}
+
+func TestCrossFieldParamLabels(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		names    *FieldDictionary
+		expected map[string]string
+	}{
+		{
+			name: "nominative",
+			names: NewFieldDictionary().
+				Add("StartAt", "Время начала").
+				Add("EndAt", "Время окончания").
+				Add("Deadline", "Крайний срок"),
+			expected: map[string]string{
+				"meeting.Interval.EndAt": "Поле Время окончания должно быть больше Время начала",
+				"meeting.Deadline":       "Поле Крайний срок должно быть больше Время начала",
+				"meeting.Agenda":         "Поле Agenda должно быть равно Крайний срок",
+				"meeting.Notes":          "Поле Notes должно быть менее или равно Unknown",
+			},
+		},
+		{
+			name: "declined",
+			names: NewFieldDictionary().
+				AddLabel("StartAt", Label{Name: "Время начала", Genitive: "Времени начала"}).
+				AddLabel("Interval.StartAt", Label{Name: "Начало интервала", Genitive: "Начала интервала"}).
+				AddLabel("Deadline", Label{Name: "Крайний срок", Dative: "Крайнему сроку"}),
+			expected: map[string]string{
+				"meeting.Interval.EndAt": "Поле EndAt должно быть больше Начала интервала",
+				"meeting.Deadline":       "Поле Крайний срок должно быть больше Начала интервала",
+				"meeting.Agenda":         "Поле Agenda должно быть равно Крайнему сроку",
+				"meeting.Notes":          "Поле Notes должно быть менее или равно Unknown",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			validate := validator.New()
+			trans := NewTranslator()
+
+			if err := RegisterDefaultTranslations(validate, trans, WithFieldNames(tt.names)); err != nil {
+				t.Fatalf("RegisterDefaultTranslations: %v", err)
+			}
+
+			err := validate.Struct(meeting{Interval: interval{StartAt: 2}, Agenda: 1, Notes: 1})
+
+			errs, ok := err.(validator.ValidationErrors)
+			if !ok {
+				t.Fatalf("expected validation errors, got %v", err)
+			}
+
+			if len(errs) != len(tt.expected) {
+				t.Fatalf("got %d errors, want %d: %v", len(errs), len(tt.expected), errs)
+			}
+
+			for _, fe := range errs {
+				if got := fe.Translate(trans); got != tt.expected[fe.Namespace()] {
+					t.Errorf("%s: got %q, want %q", fe.Namespace(), got, tt.expected[fe.Namespace()])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+// Package ru provides Russian translations for the error messages produced by
+// github.com/go-playground/validator/v10.
+//
+// The translations are registered on a *validator.Validate for a ut.Translator
+// built for the "ru" locale:
+//
+//	validate := validator.New()
+//	trans := ru.NewTranslator()
+//
+//	if err := ru.RegisterDefaultTranslations(validate, trans); err != nil {
+//		return err
+//	}
+//
+// Field labels are taken from a FieldDictionary instead of the Go field names:
+//
+//	names := ru.NewFieldDictionary().
+//		Add("Title", "Название").
+//		Add("Event.StartAt", "Время начала")
+//
+//	err := ru.RegisterDefaultTranslations(validate, trans, ru.WithFieldNames(names))
+//
+// or read from struct tags next to the fields they describe:
+//
+//	type Event struct {
+//		StartAt time.Time `json:"start_at" ru:"Дата начала,f" validate:"required"`
+//	}
+//
+//	names.AddTagged(ru.LabelTag, Event{})
+//
+// Tags are read in the registered types and in the structs nested in them,
+// and take precedence over the keys of the dictionary. The names validator
+// reports for the fields are left untouched.
+//
+// Messages in which the label is the subject agree with its Gender, giving
+// "Дата начала обязательна" rather than "Дата начала обязательное поле".
+//
+// Numeric bounds are written the Russian way, 1e4 becoming "10 000", and
+// followed by the Unit of the label if it has one: a field tagged
+// ru:"Цена,f,unit=₽" with validate:"max=1e4" must be "меньше или равно
+// 10 000 ₽". Fields holding a size opt in to having the bounds of len, min
+// and max rendered as sizes with their Size unit: a []byte tagged
+// ru:"Вложение,n,size=bytes" with validate:"max=1048576" must be "не более
+// 1 МБ".
+//
+// Application specific tags are added with RegisterExtraTranslations:
+//
+//	err := ru.RegisterExtraTranslations(validate, trans, ru.Catalog{
+//		{Tag: "starRating", Translation: "Недопустимая оценка"},
+//	})
+//
+// Their placeholders may select the case of the label, provided by its
+// declined forms:
+//
+//	{Tag: "inFuture", Translation: "Значение {0:gen} должно быть в будущем"}
+//
+// FieldErrors that cannot be translated are rendered with their English
+// message. Such failures are only reported when a logger is configured:
+//
+//	err := ru.RegisterDefaultTranslations(validate, trans, ru.WithLogger(slog.Default()))
+//
+// TranslateErrors reports them as a *TranslationError instead, and
+// MustTranslateErrors panics on them, which helps catching missing
+// translations during development.
+//
+// lt, lte, gt and gte on time.Time fields render the moment validator
+// compared the field with, which is always the current one: "Поле Срок
+// должно быть не позднее 17.10.2026 15:00 МСК". It is shown in Moscow time
+// with DefaultTimeLayout; WithTimeZone and WithTimeLayout change that:
+//
+//	err := ru.RegisterDefaultTranslations(validate, trans,
+//		ru.WithTimeZone(time.FixedZone("ЕКБ", 5*60*60)),
+//		ru.WithTimeLayout("02.01.2006 15:04 (MST)"),
+//	)
+//
+// Translated messages are obtained from the validation errors:
+//
+//	err := validate.Struct(req)
+//	if errs, ok := err.(validator.ValidationErrors); ok {
+//		for _, fe := range errs {
+//			fmt.Println(fe.Translate(trans))
+//		}
+//	}
+package ru
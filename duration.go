@@ -0,0 +1,146 @@
+package ru
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+)
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// durationUnits lists the units durations are spelled out in, largest
+// first. Hours are not folded into days, as in time.Duration itself.
+var durationUnits = []struct {
+	size  time.Duration
+	forms map[Case]pluralForms
+}{
+	{
+		size: time.Hour,
+		forms: map[Case]pluralForms{
+			Accusative: {one: "{0} час", few: "{0} часа", many: "{0} часов", other: "{0} часа"},
+			Genitive:   {one: "{0} часа", few: "{0} часов", many: "{0} часов", other: "{0} часа"},
+		},
+	},
+	{
+		size: time.Minute,
+		forms: map[Case]pluralForms{
+			Accusative: {one: "{0} минуту", few: "{0} минуты", many: "{0} минут", other: "{0} минуты"},
+			Genitive:   {one: "{0} минуты", few: "{0} минут", many: "{0} минут", other: "{0} минуты"},
+		},
+	},
+	{
+		size: time.Second,
+		forms: map[Case]pluralForms{
+			Accusative: {one: "{0} секунду", few: "{0} секунды", many: "{0} секунд", other: "{0} секунды"},
+			Genitive:   {one: "{0} секунды", few: "{0} секунд", many: "{0} секунд", other: "{0} секунды"},
+		},
+	},
+	{
+		size: time.Millisecond,
+		forms: map[Case]pluralForms{
+			Accusative: {one: "{0} миллисекунду", few: "{0} миллисекунды", many: "{0} миллисекунд", other: "{0} миллисекунды"},
+			Genitive:   {one: "{0} миллисекунды", few: "{0} миллисекунд", many: "{0} миллисекунд", other: "{0} миллисекунды"},
+		},
+	},
+	{
+		size: time.Microsecond,
+		forms: map[Case]pluralForms{
+			Accusative: {one: "{0} микросекунду", few: "{0} микросекунды", many: "{0} микросекунд", other: "{0} микросекунды"},
+			Genitive:   {one: "{0} микросекунды", few: "{0} микросекунд", many: "{0} микросекунд", other: "{0} микросекунды"},
+		},
+	},
+	{
+		size: time.Nanosecond,
+		forms: map[Case]pluralForms{
+			Accusative: {one: "{0} наносекунду", few: "{0} наносекунды", many: "{0} наносекунд", other: "{0} наносекунды"},
+			Genitive:   {one: "{0} наносекунды", few: "{0} наносекунд", many: "{0} наносекунд", other: "{0} наносекунды"},
+		},
+	},
+}
+
+// isDuration reports whether t, or the type t points to, is time.Duration.
+func isDuration(t reflect.Type) bool {
+
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	return t == durationType
+}
+
+// parseDuration parses the parameter of a tag on a time.Duration field the
+// way validator does: as a duration string, or else as nanoseconds.
+func parseDuration(param string) (time.Duration, error) {
+
+	d, err := time.ParseDuration(param)
+	if err == nil {
+		return d, nil
+	}
+
+	n, nerr := strconv.ParseInt(param, 10, 64)
+	if nerr != nil {
+		return 0, err
+	}
+
+	return time.Duration(n), nil
+}
+
+// humanizeDuration spells d out in Russian in case c, which must be
+// Accusative or Genitive: "1 час 30 минут", "1 часа 30 минут".
+func humanizeDuration(ut ut.Translator, d time.Duration, c Case) string {
+
+	var parts []string
+
+	sign := ""
+	if d < 0 {
+		sign = "-"
+		d = -d
+	}
+
+	for _, unit := range durationUnits {
+
+		n := d / unit.size
+		if n == 0 {
+			continue
+		}
+
+		d -= n * unit.size
+		parts = append(parts, countForm(ut, unit.forms[c], int64(n)))
+	}
+
+	if len(parts) == 0 {
+		// seconds are the unit a zero duration is usually spoken of in
+		return countForm(ut, durationUnits[2].forms[c], 0)
+	}
+
+	return sign + strings.Join(parts, " ")
+}
+
+// countForm renders n followed by the form of forms it takes.
+func countForm(ut ut.Translator, forms pluralForms, n int64) string {
+
+	f := float64(n)
+
+	return strings.Replace(forms.form(ut.CardinalPluralRule(f, 0)), "{0}", formatNumber(f, 0), 1)
+}
+
+// translateDuration translates fe reported for a time.Duration field with
+// the translation key, spelling the parameter out in case c.
+func (o *options) translateDuration(ut ut.Translator, fe validator.FieldError, key string, c Case) string {
+
+	d, err := parseDuration(fe.Param())
+	if err != nil {
+		return o.fallback(fe, err)
+	}
+
+	t, err := ut.T(key, o.fieldName(fe), humanizeDuration(ut, d, c))
+	if err != nil {
+		return o.fallback(fe, err)
+	}
+
+	return t
+}
@@ -0,0 +1,38 @@
+package ru
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHumanizeDuration(t *testing.T) {
+
+	trans := NewTranslator()
+
+	tests := []struct {
+		d          time.Duration
+		accusative string
+		genitive   string
+	}{
+		{d: 0, accusative: "0 секунд", genitive: "0 секунд"},
+		{d: time.Hour, accusative: "1 час", genitive: "1 часа"},
+		{d: 2 * time.Hour, accusative: "2 часа", genitive: "2 часов"},
+		{d: 5 * time.Hour, accusative: "5 часов", genitive: "5 часов"},
+		{d: 21 * time.Minute, accusative: "21 минуту", genitive: "21 минуты"},
+		{d: 12 * time.Minute, accusative: "12 минут", genitive: "12 минут"},
+		{d: 90 * time.Minute, accusative: "1 час 30 минут", genitive: "1 часа 30 минут"},
+		{d: 1001 * time.Hour, accusative: "1\u202f001 час", genitive: "1\u202f001 часа"},
+		{d: 3*time.Second + 2*time.Millisecond, accusative: "3 секунды 2 миллисекунды", genitive: "3 секунд 2 миллисекунд"},
+		{d: 1500 * time.Nanosecond, accusative: "1 микросекунду 500 наносекунд", genitive: "1 микросекунды 500 наносекунд"},
+		{d: -time.Minute, accusative: "-1 минуту", genitive: "-1 минуты"},
+	}
+
+	for _, tt := range tests {
+		if got := humanizeDuration(trans, tt.d, Accusative); got != tt.accusative {
+			t.Errorf("humanizeDuration(%v, Accusative) = %q, want %q", tt.d, got, tt.accusative)
+		}
+		if got := humanizeDuration(trans, tt.d, Genitive); got != tt.genitive {
+			t.Errorf("humanizeDuration(%v, Genitive) = %q, want %q", tt.d, got, tt.genitive)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package ru_test
+
+import (
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+
+	ru "github.com/xdmitriy/go-validator-ru-translation"
+)
+
+func ExampleRegisterDefaultTranslations() {
+
+	validate := validator.New()
+	trans := ru.NewTranslator()
+
+	if err := ru.RegisterDefaultTranslations(validate, trans); err != nil {
+		panic(err)
+	}
+
+	type request struct {
+		Name string `validate:"required"`
+	}
+
+	err := validate.Struct(request{})
+	for _, fe := range err.(validator.ValidationErrors) {
+		fmt.Println(fe.Translate(trans))
+	}
+
+	// Output: Name обязательное поле
+}
+
+func ExampleRegisterExtraTranslations() {
+
+	validate := validator.New()
+	trans := ru.NewTranslator()
+
+	if err := ru.RegisterDefaultTranslations(validate, trans); err != nil {
+		panic(err)
+	}
+
+	_ = validate.RegisterValidation("starRating", func(fl validator.FieldLevel) bool {
+		return fl.Field().Int() >= 1 && fl.Field().Int() <= 5
+	})
+
+	err := ru.RegisterExtraTranslations(validate, trans, ru.Catalog{
+		{Tag: "starRating", Translation: "Недопустимая оценка"},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	type review struct {
+		Rating int `validate:"starRating"`
+	}
+
+	err = validate.Struct(review{Rating: 7})
+	for _, fe := range err.(validator.ValidationErrors) {
+		fmt.Println(fe.Translate(trans))
+	}
+
+	// Output: Недопустимая оценка
+}
@@ -0,0 +1,356 @@
+package ru
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// FieldDictionary maps struct fields to their Russian labels.
+//
+// Keys are Go field names, optionally qualified with a suffix of the struct
+// namespace reported by validator, in which the first element is the name
+// of the validated type and the following ones are field names: "Title"
+// applies to every field named Title, "Event.Title" to the Title field of a
+// validated Event and "Events.Title" to the Title of the elements of an
+// Events field. Slice and map indexes are ignored. The most qualified key
+// wins.
+type FieldDictionary struct {
+	mu     sync.RWMutex
+	names  map[string]Label
+	roots  map[string][]taggedType
+	labels map[taggedType]map[string]Label
+}
+
+// Label is the Russian name of a field. Name is in the nominative case, the
+// optional declined forms are used by templates selecting a case, see Case.
+type Label struct {
+	Name   string
+	Gender Gender
+
+	Genitive      string
+	Dative        string
+	Accusative    string
+	Instrumental  string
+	Prepositional string
+
+	// Unit follows the numeric bounds of the field, e.g. "₽" renders the
+	// bound of max=10000 as "10 000 ₽".
+	Unit string
+
+	// Size is the unit the field measures a size in, see SizeUnit.
+	Size SizeUnit
+}
+
+// LabelTag is the struct tag conventionally holding field labels, e.g.
+//
+//	StartAt time.Time `json:"start_at" ru:"Дата начала,f"`
+//
+// The name may be followed by the gender of the label: m, f, n or pl, and by
+// its declined forms: gen=, dat=, acc=, ins= and prep=, e.g.
+//
+//	ru:"Дата начала,f,gen=Даты начала,ins=Датой начала"
+//
+// and by the unit of its numeric bounds or, for fields holding a size, by
+// the size unit, bytes or kilobytes:
+//
+//	ru:"Цена,f,unit=₽"
+//	ru:"Вложение,n,size=bytes"
+const LabelTag = "ru"
+
+// NewFieldDictionary returns an empty FieldDictionary.
+func NewFieldDictionary() *FieldDictionary {
+	return &FieldDictionary{
+		names:  make(map[string]Label),
+		roots:  make(map[string][]taggedType),
+		labels: make(map[taggedType]map[string]Label),
+	}
+}
+
+// Add registers name as the label for key, with an unknown gender.
+func (d *FieldDictionary) Add(key, name string) *FieldDictionary {
+	return d.AddLabel(key, Label{Name: name})
+}
+
+// AddLabel registers label for key.
+func (d *FieldDictionary) AddLabel(key string, label Label) *FieldDictionary {
+
+	d.mu.Lock()
+	d.names[key] = label
+	d.mu.Unlock()
+
+	return d
+}
+
+// AddStruct registers the labels of the fields of s, qualified with the
+// name of its type, for when s itself is validated. s may be a struct or a
+// pointer to one.
+func (d *FieldDictionary) AddStruct(s interface{}, labels map[string]Label) *FieldDictionary {
+
+	typ := indirectType(reflect.TypeOf(s))
+
+	d.mu.Lock()
+	for field, label := range labels {
+		d.names[typ.Name()+"."+field] = label
+	}
+	d.mu.Unlock()
+
+	return d
+}
+
+// AddTagged makes the labels found in the tag struct tag of the fields of
+// structs, and of the structs nested in them, available when structs are
+// validated. structs may be of anonymous types. The fields are only read
+// when a label is first looked up in a type, once per type and tag.
+func (d *FieldDictionary) AddTagged(tag string, structs ...interface{}) *FieldDictionary {
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, s := range structs {
+
+		typ := indirectType(reflect.TypeOf(s))
+		if typ.Kind() != reflect.Struct {
+			continue
+		}
+
+		root := taggedType{typ: typ, tag: tag}
+		if !containsTaggedType(d.roots[typ.Name()], root) {
+			d.roots[typ.Name()] = append(d.roots[typ.Name()], root)
+		}
+	}
+
+	return d
+}
+
+// taggedType is a struct type whose labels are read from the tag struct
+// tag.
+type taggedType struct {
+	typ reflect.Type
+	tag string
+}
+
+func containsTaggedType(types []taggedType, tt taggedType) bool {
+
+	for _, t := range types {
+		if t == tt {
+			return true
+		}
+	}
+
+	return false
+}
+
+// lookupTagged resolves a struct namespace such as "Request.Events[0].Title"
+// against the types registered with AddTagged, walking the fields from the
+// validated type to the struct declaring the last one. The namespaces of
+// anonymous types lack their name.
+func (d *FieldDictionary) lookupTagged(ns string) (Label, bool) {
+
+	parts := splitNamespace(ns)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if len(parts) > 1 {
+		for _, root := range d.roots[parts[0]] {
+			if label, ok := d.resolve(root, parts[1:]); ok {
+				return label, true
+			}
+		}
+	}
+
+	for _, root := range d.roots[""] {
+		if label, ok := d.resolve(root, parts); ok {
+			return label, true
+		}
+	}
+
+	return Label{}, false
+}
+
+// resolve returns the label of the field at path in root.
+func (d *FieldDictionary) resolve(root taggedType, path []string) (Label, bool) {
+
+	typ := root.typ
+
+	for _, name := range path[:len(path)-1] {
+
+		fld, ok := typ.FieldByName(name)
+		if !ok {
+			return Label{}, false
+		}
+
+		typ = indirectType(fld.Type)
+		if typ.Kind() != reflect.Struct {
+			return Label{}, false
+		}
+	}
+
+	label, ok := d.typeLabels(taggedType{typ: typ, tag: root.tag})[path[len(path)-1]]
+
+	return label, ok
+}
+
+// typeLabels returns the labels of the fields declared by tt, reading them
+// on first use. d.mu must be held.
+func (d *FieldDictionary) typeLabels(tt taggedType) map[string]Label {
+
+	if labels, ok := d.labels[tt]; ok {
+		return labels
+	}
+
+	labels := make(map[string]Label)
+
+	for i := 0; i < tt.typ.NumField(); i++ {
+
+		fld := tt.typ.Field(i)
+
+		if label, ok := parseLabel(fld.Tag.Get(tt.tag)); ok {
+			labels[fld.Name] = label
+		}
+	}
+
+	d.labels[tt] = labels
+
+	return labels
+}
+
+// parseLabel parses a label struct tag such as "Дата начала,f".
+func parseLabel(tag string) (Label, bool) {
+
+	if tag == "" || tag == "-" {
+		return Label{}, false
+	}
+
+	opts := strings.Split(tag, ",")
+	label := Label{Name: opts[0]}
+
+	for _, opt := range opts[1:] {
+
+		opt = strings.TrimSpace(opt)
+
+		if name, form, ok := strings.Cut(opt, "="); ok {
+			switch name {
+			case "unit":
+				label.Unit = form
+				continue
+			case "size":
+				label.Size = sizeUnitNames[form]
+				continue
+			}
+
+			switch caseNames[name] {
+			case Genitive:
+				label.Genitive = form
+			case Dative:
+				label.Dative = form
+			case Accusative:
+				label.Accusative = form
+			case Instrumental:
+				label.Instrumental = form
+			case Prepositional:
+				label.Prepositional = form
+			}
+			continue
+		}
+
+		switch opt {
+		case "m":
+			label.Gender = Masculine
+		case "f":
+			label.Gender = Feminine
+		case "n":
+			label.Gender = Neuter
+		case "pl":
+			label.Gender = Plural
+		}
+	}
+
+	return label, true
+}
+
+// indirectType returns the type reached by dereferencing pointers and taking
+// the element type of slices, arrays and maps.
+func indirectType(typ reflect.Type) reflect.Type {
+
+	for {
+		switch typ.Kind() {
+		case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map:
+			typ = typ.Elem()
+		default:
+			return typ
+		}
+	}
+}
+
+// Lookup returns the label of the field fe was reported for, read from its
+// tag or else registered for its namespace.
+func (d *FieldDictionary) Lookup(fe validator.FieldError) (Label, bool) {
+
+	if d == nil {
+		return Label{}, false
+	}
+
+	return d.lookupNamespace(fe.StructNamespace())
+}
+
+// LookupRelated returns the label of the field at path, such as "EndAt" or
+// "Period.EndAt", relative to the struct containing the field fe was
+// reported for.
+func (d *FieldDictionary) LookupRelated(fe validator.FieldError, path string) (Label, bool) {
+
+	if d == nil {
+		return Label{}, false
+	}
+
+	ns := fe.StructNamespace()
+	if idx := strings.LastIndexByte(ns, '.'); idx != -1 {
+		return d.lookupNamespace(ns[:idx+1] + path)
+	}
+
+	return d.lookupNamespace(path)
+}
+
+func (d *FieldDictionary) lookupNamespace(ns string) (Label, bool) {
+
+	if label, ok := d.lookupTagged(ns); ok {
+		return label, true
+	}
+
+	return d.lookup(ns)
+}
+
+// splitNamespace splits a struct namespace into its elements, dropping
+// slice and map indexes.
+func splitNamespace(ns string) []string {
+
+	parts := strings.Split(ns, ".")
+	for i, part := range parts {
+		if idx := strings.IndexByte(part, '['); idx != -1 {
+			parts[i] = part[:idx]
+		}
+	}
+
+	return parts
+}
+
+// lookup resolves a struct namespace such as "Request.Events[0].Title",
+// trying "Request.Events.Title", "Events.Title" and "Title" in turn.
+func (d *FieldDictionary) lookup(ns string) (Label, bool) {
+
+	parts := splitNamespace(ns)
+
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	for i := range parts {
+		if label, ok := d.names[strings.Join(parts[i:], ".")]; ok {
+			return label, true
+		}
+	}
+
+	return Label{}, false
+}
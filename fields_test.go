@@ -0,0 +1,185 @@
+package ru
+
+import (
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type event struct {
+	Title  string `validate:"required"`
+	Status string `validate:"required"`
+}
+
+type request struct {
+	Title  string  `validate:"required"`
+	Events []event `validate:"dive"`
+}
+
+func TestFieldDictionary(t *testing.T) {
+
+	names := NewFieldDictionary().
+		Add("Title", "Название").
+		Add("Status", "Статус").
+		Add("Events.Title", "Название события").
+		AddStruct(request{}, map[string]Label{"Title": {Name: "Заголовок"}})
+
+	validate := validator.New()
+	trans := NewTranslator()
+
+	if err := RegisterDefaultTranslations(validate, trans, WithFieldNames(names)); err != nil {
+		t.Fatalf("RegisterDefaultTranslations: %v", err)
+	}
+
+	err := validate.Struct(request{Events: []event{{}}})
+
+	errs, ok := err.(validator.ValidationErrors)
+	if !ok {
+		t.Fatalf("expected validation errors, got %v", err)
+	}
+
+	expected := map[string]string{
+		"request.Title":            "Заголовок обязательное поле",
+		"request.Events[0].Title":  "Название события обязательное поле",
+		"request.Events[0].Status": "Статус обязательное поле",
+	}
+
+	if len(errs) != len(expected) {
+		t.Fatalf("got %d errors, want %d", len(errs), len(expected))
+	}
+
+	for _, fe := range errs {
+		if got := fe.Translate(trans); got != expected[fe.Namespace()] {
+			t.Errorf("%s: got %q, want %q", fe.Namespace(), got, expected[fe.Namespace()])
+		}
+	}
+}
+
+func TestFieldNamesAreNotTags(t *testing.T) {
+
+	names := NewFieldDictionary().Add("Title", "Название")
+
+	validate := validator.New()
+	trans := NewTranslator()
+
+	if err := RegisterDefaultTranslations(validate, trans, WithFieldNames(names)); err != nil {
+		t.Fatalf("RegisterDefaultTranslations: %v", err)
+	}
+
+	if _, err := trans.T("Title"); err == nil {
+		t.Error("field label registered as a translation key")
+	}
+}
+
+type period struct {
+	StartAt string `ru:"Дата начала" validate:"required"`
+	EndAt   string `validate:"required"`
+}
+
+type booking struct {
+	Title   string   `ru:"Название" validate:"required"`
+	Period  period   `ru:"Период"`
+	Periods []period `validate:"dive"`
+	Comment string   `label:"Комментарий" validate:"required"`
+}
+
+type window struct {
+	Window period
+}
+
+func TestTaggedLabels(t *testing.T) {
+
+	anonymous := struct {
+		Name string `ru:"Имя,n" validate:"required"`
+	}{}
+
+	names := NewFieldDictionary().Add("EndAt", "Дата окончания")
+	validate, trans := newLabelledTranslator(t, names, booking{}, window{}, anonymous)
+
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected map[string]string
+	}{
+		{
+			name:  "nested",
+			value: booking{Periods: []period{{}}},
+			expected: map[string]string{
+				"booking.Title":              "Название обязательное поле",
+				"booking.Period.StartAt":     "Дата начала обязательное поле",
+				"booking.Period.EndAt":       "Дата окончания обязательное поле",
+				"booking.Periods[0].StartAt": "Дата начала обязательное поле",
+				"booking.Periods[0].EndAt":   "Дата окончания обязательное поле",
+				"booking.Comment":            "Comment обязательное поле",
+			},
+		},
+		{
+			name:  "under another field name",
+			value: window{},
+			expected: map[string]string{
+				"window.Window.StartAt": "Дата начала обязательное поле",
+				"window.Window.EndAt":   "Дата окончания обязательное поле",
+			},
+		},
+		{
+			name:  "anonymous",
+			value: anonymous,
+			expected: map[string]string{
+				"Name": "Имя обязательно",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expectTranslations(t, validate, trans, tt.value, tt.expected)
+		})
+	}
+}
+
+func TestTaggedLabelsTags(t *testing.T) {
+
+	names := NewFieldDictionary().AddTagged("label", booking{})
+	validate, trans := newLabelledTranslator(t, names)
+
+	expected := map[string]string{
+		"booking.Title":          "Title обязательное поле",
+		"booking.Period.StartAt": "StartAt обязательное поле",
+		"booking.Period.EndAt":   "EndAt обязательное поле",
+		"booking.Comment":        "Комментарий обязательное поле",
+	}
+
+	expectTranslations(t, validate, trans, booking{}, expected)
+
+	names.AddTagged(LabelTag, booking{})
+
+	expected["booking.Title"] = "Название обязательное поле"
+	expected["booking.Period.StartAt"] = "Дата начала обязательное поле"
+
+	expectTranslations(t, validate, trans, booking{}, expected)
+}
+
+type shipment struct {
+	Status string `ru:"Статус заказа,m" validate:"required"`
+}
+
+type user struct {
+	Status string
+	Role   string `ru:"Роль,f" validate:"nefield=Status"`
+}
+
+func TestTaggedRelatedLabels(t *testing.T) {
+
+	validate, trans := newLabelledTranslator(t, NewFieldDictionary(), shipment{}, user{})
+
+	for i := 0; i < 2; i++ {
+
+		expectTranslations(t, validate, trans, user{Status: "admin", Role: "admin"}, map[string]string{
+			"user.Role": "Роль не должна быть равна Status",
+		})
+
+		expectTranslations(t, validate, trans, shipment{}, map[string]string{
+			"shipment.Status": "Статус заказа обязателен",
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package ru
+
+import ut "github.com/go-playground/universal-translator"
+
+// Gender is the grammatical gender, or plural number, of a field label.
+// Messages in which the label is the subject agree with it.
+type Gender uint8
+
+// Genders of field labels. GenderUnknown labels, such as Go field names,
+// are rendered with wording that does not depend on the gender.
+const (
+	GenderUnknown Gender = iota
+	Masculine
+	Feminine
+	Neuter
+	Plural
+)
+
+// genderForms holds the variants of a message agreeing with a masculine,
+// feminine, neuter and plural label.
+type genderForms struct {
+	masculine string
+	feminine  string
+	neuter    string
+	plural    string
+}
+
+// genderKey returns the translation key of the variant of key agreeing
+// with g.
+func genderKey(key string, g Gender) string {
+
+	switch g {
+	case Masculine:
+		return key + "-masculine"
+	case Feminine:
+		return key + "-feminine"
+	case Neuter:
+		return key + "-neuter"
+	case Plural:
+		return key + "-plural"
+	default:
+		return key
+	}
+}
+
+// subjectKey returns the translation key of the variant of key in which
+// the label is the subject, for messages whose wording does not depend on
+// the gender of the label but that only read well for known labels.
+func subjectKey(key string, g Gender) string {
+
+	if g == GenderUnknown {
+		return key
+	}
+
+	return key + "-subject"
+}
+
+// addSubject adds translation under key, used for labels of unknown gender,
+// along with subject under the key returned by subjectKey.
+func (o *options) addSubject(ut ut.Translator, key string, translation string, subject string, override bool) (err error) {
+
+	if err = o.add(ut, key, translation, override); err != nil {
+		return
+	}
+
+	return o.add(ut, subjectKey(key, Masculine), subject, override)
+}
+
+// addGendered adds translation under key, used for labels of unknown gender,
+// along with the agreeing forms under the keys returned by genderKey.
+func (o *options) addGendered(ut ut.Translator, key string, translation string, forms genderForms, override bool) (err error) {
+
+	if err = o.add(ut, key, translation, override); err != nil {
+		return
+	}
+
+	if err = o.add(ut, genderKey(key, Masculine), forms.masculine, override); err != nil {
+		return
+	}
+
+	if err = o.add(ut, genderKey(key, Feminine), forms.feminine, override); err != nil {
+		return
+	}
+
+	if err = o.add(ut, genderKey(key, Neuter), forms.neuter, override); err != nil {
+		return
+	}
+
+	if err = o.add(ut, genderKey(key, Plural), forms.plural, override); err != nil {
+		return
+	}
+
+	return
+}
+
+// genderedRegistrationFunc is the registrationFunc counterpart for messages
+// agreeing with the gender of the label.
+func (o *options) genderedRegistrationFunc(tag string, translation string, forms genderForms, override bool) func(ut ut.Translator) error {
+
+	return func(ut ut.Translator) (err error) {
+		return o.addGendered(ut, tag, translation, forms, override)
+	}
+}
@@ -0,0 +1,34 @@
+package ru
+
+import (
+	"testing"
+	"time"
+)
+
+type account struct {
+	Link     string    `ru:"Ссылка,f" validate:"required"`
+	Password string    `ru:"Пароль,m,dat=Паролю" validate:"required"`
+	Title    string    `ru:"Название,n" validate:"eq=draft"`
+	Params   []string  `ru:"Параметры,pl" validate:"required"`
+	Login    string    `ru:"Логин,m" validate:"nefield=Password"`
+	StartAt  time.Time `ru:"Дата начала,f" validate:"gt"`
+	Alias    string    `ru:"Псевдоним" validate:"required"`
+}
+
+func TestGenderAgreement(t *testing.T) {
+
+	defer func(orig func() time.Time) { now = orig }(now)
+	now = func() time.Time { return time.Date(2026, time.October, 17, 15, 0, 0, 0, time.UTC) }
+
+	validate, trans := newLabelledTranslator(t, NewFieldDictionary(), account{})
+
+	expectTranslations(t, validate, trans, account{Title: "Черновик"}, map[string]string{
+		"account.Link":     "Ссылка обязательна",
+		"account.Password": "Пароль обязателен",
+		"account.Title":    "Название должно быть равно draft",
+		"account.Params":   "Параметры обязательны",
+		"account.Login":    "Логин не должен быть равен Паролю",
+		"account.StartAt":  "Дата начала должна быть позднее 17.10.2026 18:00 МСК",
+		"account.Alias":    "Псевдоним обязательное поле",
+	})
+}
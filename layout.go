@@ -0,0 +1,72 @@
+package ru
+
+import "strings"
+
+// layoutTokens maps the elements of a Go reference layout to the notation
+// Russian users know from spreadsheets: "02.01.2006 15:04" becomes
+// "ДД.ММ.ГГГГ чч:мм". Longer elements come before their prefixes.
+// Month and weekday names are marked as such and described with English
+// examples, validator only parsing the English ones.
+var layoutTokens = []struct {
+	std   string
+	desc  string
+	names bool
+}{
+	{"January", "ММММ (January, February…)", true},
+	{"Jan", "МММ (Jan, Feb…)", true},
+	{"Monday", "ДДДД (Monday, Tuesday…)", true},
+	{"Mon", "ДДД (Mon, Tue…)", true},
+	{"MST", "ЧП", false},
+	{"2006", "ГГГГ", false},
+	{"_2006", "_ГГГГ", false},
+	{"002", "ННН", false},
+	{"Z07:00:00", "±чч:мм:сс", false},
+	{"Z07:00", "±чч:мм", false},
+	{"Z0700", "±ччмм", false},
+	{"Z07", "±чч", false},
+	{"-07:00:00", "±чч:мм:сс", false},
+	{"-07:00", "±чч:мм", false},
+	{"-0700", "±ччмм", false},
+	{"-07", "±чч", false},
+	{"01", "ММ", false},
+	{"02", "ДД", false},
+	{"03", "чч", false},
+	{"04", "мм", false},
+	{"05", "сс", false},
+	{"06", "ГГ", false},
+	{"15", "чч", false},
+	{"_2", "Д", false},
+	{"1", "М", false},
+	{"2", "Д", false},
+	{"3", "ч", false},
+	{"4", "м", false},
+	{"5", "с", false},
+	{"PM", "AM/PM", false},
+	{"pm", "am/pm", false},
+}
+
+// describeLayout converts a Go time layout into its Russian description,
+// copying everything that is not a layout element, fractional seconds
+// included, as is. names reports whether the layout has month or weekday
+// names.
+func describeLayout(layout string) (desc string, names bool) {
+
+	var b strings.Builder
+
+next:
+	for i := 0; i < len(layout); {
+		for _, tok := range layoutTokens {
+			if strings.HasPrefix(layout[i:], tok.std) {
+				b.WriteString(tok.desc)
+				names = names || tok.names
+				i += len(tok.std)
+				continue next
+			}
+		}
+
+		b.WriteByte(layout[i])
+		i++
+	}
+
+	return b.String(), names
+}
@@ -0,0 +1,28 @@
+package ru
+
+import "testing"
+
+func TestDescribeLayout(t *testing.T) {
+
+	tests := []struct {
+		layout   string
+		expected string
+		names    bool
+	}{
+		{layout: "02.01.2006", expected: "ДД.ММ.ГГГГ"},
+		{layout: "2006-01-02", expected: "ГГГГ-ММ-ДД"},
+		{layout: "02.01.06 15:04", expected: "ДД.ММ.ГГ чч:мм"},
+		{layout: "2006-01-02T15:04:05.000Z07:00", expected: "ГГГГ-ММ-ДДTчч:мм:сс.000±чч:мм"},
+		{layout: "2.1.2006 3:4:5 PM", expected: "Д.М.ГГГГ ч:м:с AM/PM"},
+		{layout: "Monday, 02 January 2006", expected: "ДДДД (Monday, Tuesday…), ДД ММММ (January, February…) ГГГГ", names: true},
+		{layout: "Mon Jan _2 15:04:05 MST 2006", expected: "ДДД (Mon, Tue…) МММ (Jan, Feb…) Д чч:мм:сс ЧП ГГГГ", names: true},
+		{layout: "2006-002", expected: "ГГГГ-ННН"},
+		{layout: "15:04 -0700", expected: "чч:мм ±ччмм"},
+	}
+
+	for _, tt := range tests {
+		if got, names := describeLayout(tt.layout); got != tt.expected || names != tt.names {
+			t.Errorf("describeLayout(%q) = %q, %t, want %q, %t", tt.layout, got, names, tt.expected, tt.names)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package ru
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// groupSeparator separates the digit groups of the integer part of a
+// number: a narrow no-break space, as Russian typography requires.
+const groupSeparator = "\u202f"
+
+// parseNumber parses the numeric parameter of a tag, exponent notation
+// included, and returns it with the number of fraction digits it needs to
+// be rendered in full: "1e6" has none and "0.10" has one.
+func parseNumber(param string) (f float64, digits uint64, err error) {
+
+	f, err = strconv.ParseFloat(param, 64)
+	if err != nil {
+		return
+	}
+
+	return f, fractionDigits(f), nil
+}
+
+// fractionDigits returns the number of fraction digits f needs to be
+// rendered in full.
+func fractionDigits(f float64) uint64 {
+
+	s := strconv.FormatFloat(f, 'f', -1, 64)
+	if idx := strings.IndexByte(s, '.'); idx != -1 {
+		return uint64(len(s) - idx - 1)
+	}
+
+	return 0
+}
+
+// formatNumber renders f with digits fraction digits the Russian way:
+// digit groups separated by groupSeparator and a decimal comma,
+// "1 000 000", "0,25".
+func formatNumber(f float64, digits uint64) string {
+
+	s := strconv.FormatFloat(math.Abs(f), 'f', int(digits), 64)
+	integer, fraction, _ := strings.Cut(s, ".")
+
+	var b strings.Builder
+
+	if f < 0 {
+		b.WriteByte('-')
+	}
+
+	for i := 0; i < len(integer); i++ {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			b.WriteString(groupSeparator)
+		}
+		b.WriteByte(integer[i])
+	}
+
+	if fraction != "" {
+		b.WriteByte(',')
+		b.WriteString(fraction)
+	}
+
+	return b.String()
+}
+
+// number renders the numeric bound of a tag on the field fe was reported
+// for, followed by the unit of its label if it has one: "10 000 ₽".
+func (o *options) number(fe validator.FieldError, f float64, digits uint64) string {
+
+	s := formatNumber(f, digits)
+
+	if unit := o.label(fe).Unit; unit != "" {
+		s += "\u00a0" + unit
+	}
+
+	return s
+}
@@ -0,0 +1,57 @@
+package ru
+
+import (
+	"testing"
+)
+
+type product struct {
+	Price  float64 `ru:"Цена,f,unit=₽" validate:"max=1e4"`
+	Weight float64 `ru:"Вес,m,unit=кг" validate:"gte=0.5"`
+	Stock  int     `validate:"min=1000"`
+}
+
+func TestFormatNumber(t *testing.T) {
+
+	tests := []struct {
+		param    string
+		expected string
+	}{
+		{param: "0", expected: "0"},
+		{param: "999", expected: "999"},
+		{param: "1000", expected: "1\u202f000"},
+		{param: "10000", expected: "10\u202f000"},
+		{param: "1234567.5", expected: "1\u202f234\u202f567,5"},
+		{param: "1e6", expected: "1\u202f000\u202f000"},
+		{param: "1.5E3", expected: "1\u202f500"},
+		{param: "2.5e-3", expected: "0,0025"},
+		{param: "0.10", expected: "0,1"},
+		{param: "-12345.25", expected: "-12\u202f345,25"},
+	}
+
+	for _, tt := range tests {
+
+		f, digits, err := parseNumber(tt.param)
+		if err != nil {
+			t.Fatalf("parseNumber(%q): %v", tt.param, err)
+		}
+
+		if got := formatNumber(f, digits); got != tt.expected {
+			t.Errorf("formatNumber(%q) = %q, want %q", tt.param, got, tt.expected)
+		}
+	}
+
+	if _, _, err := parseNumber("1h"); err == nil {
+		t.Error("parseNumber(\"1h\"): expected an error")
+	}
+}
+
+func TestNumberUnits(t *testing.T) {
+
+	validate, trans := newLabelledTranslator(t, NewFieldDictionary(), product{})
+
+	expectTranslations(t, validate, trans, product{Price: 20000}, map[string]string{
+		"product.Price":  "Поле Цена должно быть меньше или равно 10\u202f000\u00a0₽",
+		"product.Weight": "Поле Вес должно быть больше или равно 0,5\u00a0кг",
+		"product.Stock":  "Поле Stock должно быть больше или равно 1\u202f000",
+	})
+}
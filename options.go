@@ -0,0 +1,105 @@
+package ru
+
+import (
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// Option configures RegisterDefaultTranslations and RegisterExtraTranslations.
+type Option func(*options)
+
+type options struct {
+	fieldNames *FieldDictionary
+	logger     *slog.Logger
+	location   *time.Location
+	timeLayout string
+
+	mu           sync.RWMutex
+	placeholders map[string][]placeholder
+}
+
+func newOptions(opts []Option) *options {
+
+	o := &options{
+		location:     moscow,
+		timeLayout:   DefaultTimeLayout,
+		placeholders: make(map[string][]placeholder),
+	}
+
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return o
+}
+
+// WithFieldNames makes the registered translations use the labels from d
+// instead of the Go field names.
+func WithFieldNames(d *FieldDictionary) Option {
+	return func(o *options) {
+		o.fieldNames = d
+	}
+}
+
+// label returns the label of the field fe was reported for, falling back
+// to the field name reported by validator.
+func (o *options) label(fe validator.FieldError) Label {
+
+	if label, ok := o.fieldNames.Lookup(fe); ok {
+		return label
+	}
+
+	return Label{Name: fe.Field()}
+}
+
+// paramLabel returns the label of the field referenced by the parameter of
+// a cross-field tag such as gtfield or eqcsfield, falling back to the
+// parameter itself.
+func (o *options) paramLabel(fe validator.FieldError) Label {
+	return o.relatedLabel(fe, fe.Param())
+}
+
+// relatedLabel returns the label of the field at path relative to the
+// struct containing the field fe was reported for, falling back to path.
+func (o *options) relatedLabel(fe validator.FieldError, path string) Label {
+
+	if label, ok := o.fieldNames.LookupRelated(fe, path); ok {
+		return label
+	}
+
+	return Label{Name: path}
+}
+
+// fieldName returns the name of the label of the field fe was reported for.
+func (o *options) fieldName(fe validator.FieldError) string {
+	return o.label(fe).Name
+}
+
+// WithLogger makes the registered translations report the FieldErrors they
+// fail to translate to logger. Failures are not reported by default.
+func WithLogger(logger *slog.Logger) Option {
+	return func(o *options) {
+		o.logger = logger
+	}
+}
+
+// fallback reports that fe could not be translated because of err and
+// returns the untranslated message of fe.
+func (o *options) fallback(fe validator.FieldError, err error) string {
+
+	if o.logger != nil {
+		o.logger.Warn("error translating FieldError",
+			slog.String("tag", fe.Tag()),
+			slog.String("field", fe.Field()),
+			slog.String("namespace", fe.Namespace()),
+			slog.String("kind", fe.Kind().String()),
+			slog.String("param", fe.Param()),
+			slog.Any("error", err),
+		)
+	}
+
+	return fe.(error).Error()
+}
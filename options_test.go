@@ -0,0 +1,145 @@
+package ru
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"testing"
+	"time"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+)
+
+type job struct {
+	Code string `validate:"untranslated"`
+}
+
+// newFailingTranslator returns a validator whose untranslated tag, used by
+// job, has a registered translation that fails, along with its translator.
+func newFailingTranslator(t *testing.T, opts ...Option) (*validator.Validate, ut.Translator) {
+
+	validate := validator.New()
+	trans := NewTranslator()
+
+	err := validate.RegisterValidation("untranslated", func(validator.FieldLevel) bool { return false })
+	if err != nil {
+		t.Fatalf("RegisterValidation: %v", err)
+	}
+
+	if err = RegisterDefaultTranslations(validate, trans, opts...); err != nil {
+		t.Fatalf("RegisterDefaultTranslations: %v", err)
+	}
+
+	// no key is added, so translating fails
+	err = RegisterExtraTranslations(validate, trans, Catalog{
+		{Tag: "untranslated", CustomRegisFunc: func(ut.Translator) error { return nil }},
+	}, opts...)
+	if err != nil {
+		t.Fatalf("RegisterExtraTranslations: %v", err)
+	}
+
+	return validate, trans
+}
+
+func TestWithLogger(t *testing.T) {
+
+	var buf bytes.Buffer
+
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	validate, trans := newFailingTranslator(t, WithLogger(logger))
+
+	fe := validate.Struct(job{}).(validator.ValidationErrors)[0]
+
+	if got := fe.Translate(trans); got != fe.Error() {
+		t.Errorf("got %q, want the untranslated message %q", got, fe.Error())
+	}
+
+	var record map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+		t.Fatalf("unexpected log output %q: %v", buf.String(), err)
+	}
+
+	expected := map[string]interface{}{
+		"level":     "WARN",
+		"tag":       "untranslated",
+		"field":     "Code",
+		"namespace": "job.Code",
+		"kind":      "string",
+		"param":     "",
+	}
+
+	for k, v := range expected {
+		if record[k] != v {
+			t.Errorf("%s: got %v, want %v", k, record[k], v)
+		}
+	}
+
+	if record["error"] == nil {
+		t.Error("error attribute is missing")
+	}
+}
+
+func TestFailuresAreSilentByDefault(t *testing.T) {
+
+	validate, trans := newFailingTranslator(t)
+
+	var buf bytes.Buffer
+
+	defer slog.SetDefault(slog.Default())
+	slog.SetDefault(slog.New(slog.NewTextHandler(&buf, nil)))
+
+	fe := validate.Struct(job{}).(validator.ValidationErrors)[0]
+	fe.Translate(trans)
+
+	if buf.Len() != 0 {
+		t.Errorf("unexpected log output %q", buf.String())
+	}
+}
+
+func TestWithTimeZoneAndLayout(t *testing.T) {
+
+	defer func(orig func() time.Time) { now = orig }(now)
+	now = func() time.Time { return time.Date(2026, time.October, 17, 12, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name     string
+		opts     []Option
+		expected string
+	}{
+		{
+			name:     "defaults",
+			expected: "Поле Deadline должно быть не позднее 17.10.2026 15:00 МСК",
+		},
+		{
+			name:     "time zone",
+			opts:     []Option{WithTimeZone(time.FixedZone("ЕКБ", 5*60*60))},
+			expected: "Поле Deadline должно быть не позднее 17.10.2026 17:00 ЕКБ",
+		},
+		{
+			name:     "layout",
+			opts:     []Option{WithTimeZone(time.UTC), WithTimeLayout("2006-01-02 15:04")},
+			expected: "Поле Deadline должно быть не позднее 2026-10-17 12:00",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			validate := validator.New()
+			trans := NewTranslator()
+
+			if err := RegisterDefaultTranslations(validate, trans, tt.opts...); err != nil {
+				t.Fatalf("RegisterDefaultTranslations: %v", err)
+			}
+
+			got := translateFirst(t, validate, trans, struct {
+				Deadline time.Time `validate:"lte"`
+			}{Deadline: time.Now().Add(time.Hour)})
+
+			if got != tt.expected {
+				t.Errorf("got %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
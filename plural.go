@@ -0,0 +1,93 @@
+package ru
+
+import (
+	"github.com/go-playground/locales"
+	ut "github.com/go-playground/universal-translator"
+)
+
+// pluralForms holds the forms of a counted noun for the CLDR cardinal plural
+// categories of the ru locale: one (1, 21, 101), few (2-4, 22-24), many
+// (0, 5-20, 25-30) and other, which only applies to fractional numbers
+// (1,5, 2,25).
+type pluralForms struct {
+	one   string
+	few   string
+	many  string
+	other string
+}
+
+var (
+	// charactersAccusative counts characters after "в", "минимум", "максимум":
+	// "минимум 2 символа".
+	charactersAccusative = pluralForms{
+		one:   "{0} символ",
+		few:   "{0} символа",
+		many:  "{0} символов",
+		other: "{0} символа",
+	}
+
+	// charactersGenitive counts characters after "менее", "длиннее":
+	// "длиннее 2 символов".
+	charactersGenitive = pluralForms{
+		one:   "{0} символа",
+		few:   "{0} символов",
+		many:  "{0} символов",
+		other: "{0} символа",
+	}
+
+	// itemsAccusative counts items after "содержать", "минимум", "максимум":
+	// "минимум 2 элемента".
+	itemsAccusative = pluralForms{
+		one:   "{0} элемент",
+		few:   "{0} элемента",
+		many:  "{0} элементов",
+		other: "{0} элемента",
+	}
+
+	// itemsGenitive counts items after "менее", "более":
+	// "более 2 элементов".
+	itemsGenitive = pluralForms{
+		one:   "{0} элемента",
+		few:   "{0} элементов",
+		many:  "{0} элементов",
+		other: "{0} элемента",
+	}
+)
+
+// addCardinal adds the cardinal translations of key for every plural
+// category of the ru locale.
+func addCardinal(ut ut.Translator, key string, forms pluralForms, override bool) (err error) {
+
+	if err = ut.AddCardinal(key, forms.one, locales.PluralRuleOne, override); err != nil {
+		return
+	}
+
+	if err = ut.AddCardinal(key, forms.few, locales.PluralRuleFew, override); err != nil {
+		return
+	}
+
+	if err = ut.AddCardinal(key, forms.many, locales.PluralRuleMany, override); err != nil {
+		return
+	}
+
+	if err = ut.AddCardinal(key, forms.other, locales.PluralRuleOther, override); err != nil {
+		return
+	}
+
+	return
+}
+
+// form returns the form for the plural category rule.
+func (f pluralForms) form(rule locales.PluralRule) string {
+
+	switch rule {
+	case locales.PluralRuleOne:
+		return f.one
+	case locales.PluralRuleFew:
+		return f.few
+	case locales.PluralRuleMany:
+		return f.many
+	default:
+		return f.other
+	}
+}
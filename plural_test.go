@@ -0,0 +1,112 @@
+package ru
+
+import (
+	"math"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// noun holds the forms of a counted noun: nominative singular, genitive
+// singular and genitive plural.
+type noun struct {
+	nom, gen, genPl string
+}
+
+var (
+	character = noun{nom: "символ", gen: "символа", genPl: "символов"}
+	item      = noun{nom: "элемент", gen: "элемента", genPl: "элементов"}
+)
+
+// expectedForm returns the form of w counted by n with v fraction digits,
+// after a preposition or adverb governing the genitive when genitive is set.
+func expectedForm(w noun, n float64, v uint64, genitive bool) string {
+
+	if v > 0 {
+		return w.gen
+	}
+
+	i := int64(math.Abs(n))
+
+	switch {
+	case i%10 == 1 && i%100 != 11:
+		if genitive {
+			return w.gen
+		}
+		return w.nom
+	case i%10 >= 2 && i%10 <= 4 && (i%100 < 12 || i%100 > 14):
+		if genitive {
+			return w.genPl
+		}
+		return w.gen
+	default:
+		return w.genPl
+	}
+}
+
+func TestCardinalForms(t *testing.T) {
+
+	validate := validator.New()
+	trans := NewTranslator()
+
+	if err := RegisterDefaultTranslations(validate, trans); err != nil {
+		t.Fatalf("RegisterDefaultTranslations: %v", err)
+	}
+
+	keys := []struct {
+		key      string
+		noun     noun
+		genitive bool
+	}{
+		{key: "len-string-character", noun: character},
+		{key: "len-items-item", noun: item},
+		{key: "min-string-character", noun: character},
+		{key: "min-items-item", noun: item},
+		{key: "max-string-character", noun: character},
+		{key: "max-items-item", noun: item},
+		{key: "lte-string-character", noun: character},
+		{key: "lte-items-item", noun: item},
+		{key: "gte-string-character", noun: character},
+		{key: "gte-items-item", noun: item},
+		{key: "lt-string-character", noun: character, genitive: true},
+		{key: "lt-items-item", noun: item, genitive: true},
+		{key: "gt-string-character", noun: character, genitive: true},
+		{key: "gt-items-item", noun: item, genitive: true},
+	}
+
+	type number struct {
+		n float64
+		v uint64
+	}
+
+	var numbers []number
+
+	for i := 0; i <= 200; i++ {
+		numbers = append(numbers, number{n: float64(i)})
+	}
+
+	for _, f := range []string{"0.5", "1.5", "2.5", "5.5", "11.5", "21.5", "2.25", "0.125"} {
+
+		n, v, _ := parseNumber(f)
+
+		numbers = append(numbers, number{n: n, v: v})
+	}
+
+	for _, k := range keys {
+		t.Run(k.key, func(t *testing.T) {
+			for _, num := range numbers {
+
+				formatted := formatNumber(num.n, num.v)
+
+				got, err := trans.C(k.key, num.n, num.v, formatted)
+				if err != nil {
+					t.Fatalf("%s: %v", formatted, err)
+				}
+
+				if expected := formatted + " " + expectedForm(k.noun, num.n, num.v, k.genitive); got != expected {
+					t.Errorf("got %q, want %q", got, expected)
+				}
+			}
+		})
+	}
+}
@@ -2,96 +2,358 @@ package ru
 
 import (
 	"fmt"
-	"log"
 	"reflect"
-	"strconv"
-	"strings"
 	"time"
 
-	"github.com/go-playground/locales"
 	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
 )
 
 // RegisterDefaultTranslations registers a set of default translations
 // for all built in tag's in validator; you may add your own as desired.
-func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (err error) {
+//
+// trans must be a translator for the "ru" locale, see NewTranslator.
+func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator, opts ...Option) (err error) {
 
-	translations := []struct {
-		tag             string
-		translation     string
-		override        bool
-		customRegisFunc validator.RegisterTranslationsFunc
-		customTransFunc validator.TranslationFunc
-	}{
+	o := newOptions(opts)
+
+	translations := Catalog{
 		{
-			tag:         "required",
-			translation: "{0} обязательное поле",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
-				t, err := ut.T(fe.Tag(), fld)
+			Tag: "required",
+			CustomRegisFunc: o.genderedRegistrationFunc("required", "{0} обязательное поле", genderForms{
+				masculine: "{0} обязателен",
+				feminine:  "{0} обязательна",
+				neuter:    "{0} обязательно",
+				plural:    "{0} обязательны",
+			}, false),
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				lbl := o.label(fe)
+				t, err := ut.T(genderKey(fe.Tag(), lbl.Gender), lbl.Name)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag: "len",
-			customRegisFunc: func(ut ut.Translator) (err error) {
+			Tag: "required_if",
+			CustomRegisFunc: func(ut ut.Translator) (err error) {
 
-				if err = ut.Add("len-string", "Поле {0} должно быть длиной в {1}", false); err != nil {
+				if err = o.addGendered(ut, "required_if", "Поле {0} обязательно, если {1}", genderForms{
+					masculine: "{0} обязателен, если {1}",
+					feminine:  "{0} обязательна, если {1}",
+					neuter:    "{0} обязательно, если {1}",
+					plural:    "{0} обязательны, если {1}",
+				}, false); err != nil {
 					return
 				}
 
-				if err = ut.AddCardinal("len-string-character", "{0} символ", locales.PluralRuleOne, false); err != nil {
+				if err = o.addGendered(ut, "required_if-condition", "поле {0} равно «{1}»", genderForms{
+					masculine: "{0} равен «{1}»",
+					feminine:  "{0} равна «{1}»",
+					neuter:    "{0} равно «{1}»",
+					plural:    "{0} равны «{1}»",
+				}, false); err != nil {
 					return
 				}
 
-				if err = ut.AddCardinal("len-string-character", "{0} символы", locales.PluralRuleOther, false); err != nil {
+				return
+			},
+			CustomTransFunc: o.conditionalTransFunc(genderKey, func(ut ut.Translator, fe validator.FieldError) (string, error) {
+				return o.valueCondition(ut, fe, "required_if-condition", " и ")
+			}),
+		},
+		{
+			Tag: "required_unless",
+			CustomRegisFunc: func(ut ut.Translator) (err error) {
+
+				if err = o.addGendered(ut, "required_unless", "Поле {0} обязательно, если {1}", genderForms{
+					masculine: "{0} обязателен, если {1}",
+					feminine:  "{0} обязательна, если {1}",
+					neuter:    "{0} обязательно, если {1}",
+					plural:    "{0} обязательны, если {1}",
+				}, false); err != nil {
 					return
 				}
 
-				if err = ut.AddCardinal("len-string-character", "{0} символа", locales.PluralRuleFew, false); err != nil {
+				if err = o.addGendered(ut, "required_unless-condition", "поле {0} не равно «{1}»", genderForms{
+					masculine: "{0} не равен «{1}»",
+					feminine:  "{0} не равна «{1}»",
+					neuter:    "{0} не равно «{1}»",
+					plural:    "{0} не равны «{1}»",
+				}, false); err != nil {
 					return
 				}
 
-				if err = ut.AddCardinal("len-string-character", "{0} символов", locales.PluralRuleMany, false); err != nil {
+				return
+			},
+			CustomTransFunc: o.conditionalTransFunc(genderKey, func(ut ut.Translator, fe validator.FieldError) (string, error) {
+				return o.valueCondition(ut, fe, "required_unless-condition", " и ")
+			}),
+		},
+		{
+			Tag: "required_with",
+			CustomRegisFunc: func(ut ut.Translator) (err error) {
+
+				if err = o.addGendered(ut, "required_with", "Поле {0} обязательно, если {1}", genderForms{
+					masculine: "{0} обязателен, если {1}",
+					feminine:  "{0} обязательна, если {1}",
+					neuter:    "{0} обязательно, если {1}",
+					plural:    "{0} обязательны, если {1}",
+				}, false); err != nil {
 					return
 				}
 
-				if err = ut.Add("len-number", "Поле {0} должно быть равно {1}", false); err != nil {
+				if err = ut.Add("required_with-condition", "заполнено поле {0}", false); err != nil {
 					return
 				}
 
-				if err = ut.Add("len-items", "Поле {0} должно содержать {1}", false); err != nil {
+				if err = ut.Add("required_with-condition-multiple", "заполнено хотя бы одно из полей {0}", false); err != nil {
+					return
+				}
+
+				return
+			},
+			CustomTransFunc: o.conditionalTransFunc(genderKey, func(ut ut.Translator, fe validator.FieldError) (string, error) {
+				return o.presenceCondition(ut, fe, "required_with-condition", ", ")
+			}),
+		},
+		{
+			Tag: "required_with_all",
+			CustomRegisFunc: func(ut ut.Translator) (err error) {
+
+				if err = o.addGendered(ut, "required_with_all", "Поле {0} обязательно, если {1}", genderForms{
+					masculine: "{0} обязателен, если {1}",
+					feminine:  "{0} обязательна, если {1}",
+					neuter:    "{0} обязательно, если {1}",
+					plural:    "{0} обязательны, если {1}",
+				}, false); err != nil {
+					return
+				}
+
+				if err = ut.Add("required_with_all-condition", "заполнено поле {0}", false); err != nil {
+					return
+				}
+
+				if err = ut.Add("required_with_all-condition-multiple", "заполнены поля {0}", false); err != nil {
+					return
+				}
+
+				return
+			},
+			CustomTransFunc: o.conditionalTransFunc(genderKey, func(ut ut.Translator, fe validator.FieldError) (string, error) {
+				return o.presenceCondition(ut, fe, "required_with_all-condition", " и ")
+			}),
+		},
+		{
+			Tag: "required_without",
+			CustomRegisFunc: func(ut ut.Translator) (err error) {
+
+				if err = o.addGendered(ut, "required_without", "Поле {0} обязательно, если {1}", genderForms{
+					masculine: "{0} обязателен, если {1}",
+					feminine:  "{0} обязательна, если {1}",
+					neuter:    "{0} обязательно, если {1}",
+					plural:    "{0} обязательны, если {1}",
+				}, false); err != nil {
+					return
+				}
+
+				if err = ut.Add("required_without-condition-multiple", "не заполнено хотя бы одно из полей {0}", false); err != nil {
+					return
+				}
+
+				if err = ut.Add("required_without-one-of", "Укажите {0}", false); err != nil {
+					return
+				}
+
+				return
+			},
+			CustomTransFunc: o.oneOfTransFunc(o.conditionalTransFunc(genderKey, func(ut ut.Translator, fe validator.FieldError) (string, error) {
+				return o.presenceCondition(ut, fe, "required_without-condition", ", ")
+			})),
+		},
+		{
+			Tag: "required_without_all",
+			CustomRegisFunc: func(ut ut.Translator) (err error) {
+
+				if err = ut.Add("required_without_all-one-of", "Укажите {0}", false); err != nil {
+					return
+				}
+
+				return
+			},
+			CustomTransFunc: o.oneOfTransFunc(nil),
+		},
+		{
+			Tag: "excluded_if",
+			CustomRegisFunc: func(ut ut.Translator) (err error) {
+
+				if err = o.addSubject(ut, "excluded_if", "Поле {0} нельзя заполнять, если {1}", "{0} нельзя заполнять, если {1}", false); err != nil {
+					return
+				}
+
+				if err = o.addGendered(ut, "excluded_if-condition", "поле {0} равно «{1}»", genderForms{
+					masculine: "{0} равен «{1}»",
+					feminine:  "{0} равна «{1}»",
+					neuter:    "{0} равно «{1}»",
+					plural:    "{0} равны «{1}»",
+				}, false); err != nil {
+					return
+				}
+
+				return
+			},
+			CustomTransFunc: o.conditionalTransFunc(subjectKey, func(ut ut.Translator, fe validator.FieldError) (string, error) {
+				return o.valueCondition(ut, fe, "excluded_if-condition", " и ")
+			}),
+		},
+		{
+			Tag: "excluded_unless",
+			CustomRegisFunc: func(ut ut.Translator) (err error) {
+
+				if err = o.addSubject(ut, "excluded_unless", "Поле {0} нельзя заполнять, если {1}", "{0} нельзя заполнять, если {1}", false); err != nil {
+					return
+				}
+
+				if err = o.addGendered(ut, "excluded_unless-condition", "поле {0} не равно «{1}»", genderForms{
+					masculine: "{0} не равен «{1}»",
+					feminine:  "{0} не равна «{1}»",
+					neuter:    "{0} не равно «{1}»",
+					plural:    "{0} не равны «{1}»",
+				}, false); err != nil {
+					return
+				}
+
+				return
+			},
+			CustomTransFunc: o.conditionalTransFunc(subjectKey, func(ut ut.Translator, fe validator.FieldError) (string, error) {
+				return o.valueCondition(ut, fe, "excluded_unless-condition", " и ")
+			}),
+		},
+		{
+			Tag: "excluded_with",
+			CustomRegisFunc: func(ut ut.Translator) (err error) {
+
+				if err = o.addSubject(ut, "excluded_with", "Поле {0} нельзя заполнять, если {1}", "{0} нельзя заполнять, если {1}", false); err != nil {
+					return
+				}
+
+				if err = ut.Add("excluded_with-condition", "заполнено поле {0}", false); err != nil {
+					return
+				}
+
+				if err = ut.Add("excluded_with-condition-multiple", "заполнено хотя бы одно из полей {0}", false); err != nil {
+					return
+				}
+
+				return
+			},
+			CustomTransFunc: o.conditionalTransFunc(subjectKey, func(ut ut.Translator, fe validator.FieldError) (string, error) {
+				return o.presenceCondition(ut, fe, "excluded_with-condition", ", ")
+			}),
+		},
+		{
+			Tag: "excluded_with_all",
+			CustomRegisFunc: func(ut ut.Translator) (err error) {
+
+				if err = o.addSubject(ut, "excluded_with_all", "Поле {0} нельзя заполнять, если {1}", "{0} нельзя заполнять, если {1}", false); err != nil {
+					return
+				}
+
+				if err = ut.Add("excluded_with_all-condition", "заполнено поле {0}", false); err != nil {
+					return
+				}
+
+				if err = ut.Add("excluded_with_all-condition-multiple", "заполнены поля {0}", false); err != nil {
+					return
+				}
+
+				return
+			},
+			CustomTransFunc: o.conditionalTransFunc(subjectKey, func(ut ut.Translator, fe validator.FieldError) (string, error) {
+				return o.presenceCondition(ut, fe, "excluded_with_all-condition", " и ")
+			}),
+		},
+		{
+			Tag: "excluded_without",
+			CustomRegisFunc: func(ut ut.Translator) (err error) {
+
+				if err = o.addSubject(ut, "excluded_without", "Поле {0} нельзя заполнять, если {1}", "{0} нельзя заполнять, если {1}", false); err != nil {
+					return
+				}
+
+				if err = ut.Add("excluded_without-condition", "не заполнено поле {0}", false); err != nil {
+					return
+				}
+
+				if err = ut.Add("excluded_without-condition-multiple", "не заполнено хотя бы одно из полей {0}", false); err != nil {
+					return
+				}
+
+				return
+			},
+			CustomTransFunc: o.conditionalTransFunc(subjectKey, func(ut ut.Translator, fe validator.FieldError) (string, error) {
+				return o.presenceCondition(ut, fe, "excluded_without-condition", ", ")
+			}),
+		},
+		{
+			Tag: "excluded_without_all",
+			CustomRegisFunc: func(ut ut.Translator) (err error) {
+
+				if err = o.addSubject(ut, "excluded_without_all", "Поле {0} нельзя заполнять, если {1}", "{0} нельзя заполнять, если {1}", false); err != nil {
+					return
+				}
+
+				if err = ut.Add("excluded_without_all-condition", "не заполнено поле {0}", false); err != nil {
+					return
+				}
+
+				if err = ut.Add("excluded_without_all-condition-multiple", "не заполнены поля {0}", false); err != nil {
+					return
+				}
+
+				return
+			},
+			CustomTransFunc: o.conditionalTransFunc(subjectKey, func(ut ut.Translator, fe validator.FieldError) (string, error) {
+				return o.presenceCondition(ut, fe, "excluded_without_all-condition", " и ")
+			}),
+		},
+		{
+			Tag: "len",
+			CustomRegisFunc: func(ut ut.Translator) (err error) {
+
+				if err = ut.Add("len-string", "Поле {0} должно быть длиной в {1}", false); err != nil {
+					return
+				}
+
+				if err = addCardinal(ut, "len-string-character", charactersAccusative, false); err != nil {
+					return
+				}
+
+				if err = ut.Add("len-number", "Поле {0} должно быть равно {1}", false); err != nil {
 					return
 				}
 
-				if err = ut.AddCardinal("len-items-item", "{0} элемент", locales.PluralRuleOne, false); err != nil {
+				if err = ut.Add("len-duration", "Поле {0} должно составлять {1}", false); err != nil {
 					return
 				}
 
-				if err = ut.AddCardinal("len-items-item", "{0} элементы", locales.PluralRuleOther, false); err != nil {
+				if err = ut.Add("len-size", "Поле {0} должно иметь размер {1}", false); err != nil {
 					return
 				}
 
-				if err = ut.AddCardinal("len-items-item", "{0} элемента", locales.PluralRuleFew, false); err != nil {
+				if err = ut.Add("len-items", "Поле {0} должно содержать {1}", false); err != nil {
 					return
 				}
 
-				if err = ut.AddCardinal("len-items-item", "{0} элементов", locales.PluralRuleMany, false); err != nil {
+				if err = addCardinal(ut, "len-items-item", itemsAccusative, false); err != nil {
 					return
 				}
 
 				return
 
 			},
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
 
 				var err error
 				var t string
@@ -99,17 +361,17 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				var fld string
-				fld, _ = ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
+				fld := o.fieldName(fe)
+
+				if isDuration(fe.Type()) {
+					return o.translateDuration(ut, fe, "len-duration", Accusative)
 				}
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
+				if unit, ok := o.sizeUnit(fe); ok {
+					return o.translateSize(ut, fe, "len-size", unit, Accusative)
 				}
 
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := parseNumber(fe.Param())
 				if err != nil {
 					goto END
 				}
@@ -124,7 +386,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 
 					var c string
 
-					c, err = ut.C("len-string-character", f64, digits, ut.FmtNumber(f64, digits))
+					c, err = ut.C("len-string-character", f64, digits, formatNumber(f64, digits))
 					if err != nil {
 						goto END
 					}
@@ -134,7 +396,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
 
-					c, err = ut.C("len-items-item", f64, digits, ut.FmtNumber(f64, digits))
+					c, err = ut.C("len-items-item", f64, digits, formatNumber(f64, digits))
 					if err != nil {
 						goto END
 					}
@@ -142,39 +404,26 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 					t, err = ut.T("len-items", fld, c)
 
 				default:
-					t, err = ut.T("len-number", fld, ut.FmtNumber(f64, digits))
+					t, err = ut.T("len-number", fld, o.number(fe, f64, digits))
 				}
 
 			END:
 				if err != nil {
-					fmt.Printf("warning: error translating FieldError: %s", err)
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 
 				return t
 			},
 		},
 		{
-			tag: "min",
-			customRegisFunc: func(ut ut.Translator) (err error) {
+			Tag: "min",
+			CustomRegisFunc: func(ut ut.Translator) (err error) {
 
 				if err = ut.Add("min-string", "Поле {0} должно содержать минимум {1}", false); err != nil {
 					return
 				}
 
-				if err = ut.AddCardinal("min-string-character", "{0} символ", locales.PluralRuleOne, false); err != nil {
-					return
-				}
-
-				if err = ut.AddCardinal("min-string-character", "{0} символы", locales.PluralRuleOther, false); err != nil {
-					return
-				}
-
-				if err = ut.AddCardinal("min-string-character", "{0} символа", locales.PluralRuleFew, false); err != nil {
-					return
-				}
-
-				if err = ut.AddCardinal("min-string-character", "{0} символов", locales.PluralRuleMany, false); err != nil {
+				if err = addCardinal(ut, "min-string-character", charactersAccusative, false); err != nil {
 					return
 				}
 
@@ -182,29 +431,25 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 					return
 				}
 
-				if err = ut.Add("min-items", "Поле {0} должно содержать минимум {1}", false); err != nil {
-					return
-				}
-
-				if err = ut.AddCardinal("min-items-item", "{0} элемент", locales.PluralRuleOne, false); err != nil {
+				if err = ut.Add("min-duration", "Поле {0} должно быть не менее {1}", false); err != nil {
 					return
 				}
 
-				if err = ut.AddCardinal("min-items-item", "{0} элементы", locales.PluralRuleOther, false); err != nil {
+				if err = ut.Add("min-size", "Поле {0} должно быть не менее {1}", false); err != nil {
 					return
 				}
 
-				if err = ut.AddCardinal("min-items-item", "{0} элемента", locales.PluralRuleFew, false); err != nil {
+				if err = ut.Add("min-items", "Поле {0} должно содержать минимум {1}", false); err != nil {
 					return
 				}
 
-				if err = ut.AddCardinal("min-items-item", "{0} элементов", locales.PluralRuleMany, false); err != nil {
+				if err = addCardinal(ut, "min-items-item", itemsAccusative, false); err != nil {
 					return
 				}
 				return
 
 			},
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
 
 				var err error
 				var t string
@@ -212,17 +457,17 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				var fld string
-				fld, _ = ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
+				fld := o.fieldName(fe)
+
+				if isDuration(fe.Type()) {
+					return o.translateDuration(ut, fe, "min-duration", Genitive)
 				}
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
+				if unit, ok := o.sizeUnit(fe); ok {
+					return o.translateSize(ut, fe, "min-size", unit, Genitive)
 				}
 
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := parseNumber(fe.Param())
 				if err != nil {
 					goto END
 				}
@@ -237,7 +482,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 
 					var c string
 
-					c, err = ut.C("min-string-character", f64, digits, ut.FmtNumber(f64, digits))
+					c, err = ut.C("min-string-character", f64, digits, formatNumber(f64, digits))
 					if err != nil {
 						goto END
 					}
@@ -247,7 +492,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
 
-					c, err = ut.C("min-items-item", f64, digits, ut.FmtNumber(f64, digits))
+					c, err = ut.C("min-items-item", f64, digits, formatNumber(f64, digits))
 					if err != nil {
 						goto END
 					}
@@ -255,39 +500,26 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 					t, err = ut.T("min-items", fld, c)
 
 				default:
-					t, err = ut.T("min-number", fld, ut.FmtNumber(f64, digits))
+					t, err = ut.T("min-number", fld, o.number(fe, f64, digits))
 				}
 
 			END:
 				if err != nil {
-					fmt.Printf("warning: error translating FieldError: %s", err)
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 
 				return t
 			},
 		},
 		{
-			tag: "max",
-			customRegisFunc: func(ut ut.Translator) (err error) {
+			Tag: "max",
+			CustomRegisFunc: func(ut ut.Translator) (err error) {
 
 				if err = ut.Add("max-string", "Поле {0} должно содержать максимум {1}", false); err != nil {
 					return
 				}
 
-				if err = ut.AddCardinal("max-string-character", "{0} символ", locales.PluralRuleOne, false); err != nil {
-					return
-				}
-
-				if err = ut.AddCardinal("max-string-character", "{0} символы", locales.PluralRuleOther, false); err != nil {
-					return
-				}
-
-				if err = ut.AddCardinal("max-string-character", "{0} символа", locales.PluralRuleFew, false); err != nil {
-					return
-				}
-
-				if err = ut.AddCardinal("max-string-character", "{0} символов", locales.PluralRuleMany, false); err != nil {
+				if err = addCardinal(ut, "max-string-character", charactersAccusative, false); err != nil {
 					return
 				}
 
@@ -295,30 +527,26 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 					return
 				}
 
-				if err = ut.Add("max-items", "Поле {0} должно содержать максимум {1}", false); err != nil {
-					return
-				}
-
-				if err = ut.AddCardinal("max-items-item", "{0} элемент", locales.PluralRuleOne, false); err != nil {
+				if err = ut.Add("max-duration", "Поле {0} должно быть не более {1}", false); err != nil {
 					return
 				}
 
-				if err = ut.AddCardinal("max-items-item", "{0} элементы", locales.PluralRuleOther, false); err != nil {
+				if err = ut.Add("max-size", "Поле {0} должно быть не более {1}", false); err != nil {
 					return
 				}
 
-				if err = ut.AddCardinal("max-items-item", "{0} элемента", locales.PluralRuleFew, false); err != nil {
+				if err = ut.Add("max-items", "Поле {0} должно содержать максимум {1}", false); err != nil {
 					return
 				}
 
-				if err = ut.AddCardinal("max-items-item", "{0} элементов", locales.PluralRuleMany, false); err != nil {
+				if err = addCardinal(ut, "max-items-item", itemsAccusative, false); err != nil {
 					return
 				}
 
 				return
 
 			},
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
 
 				var err error
 				var t string
@@ -326,17 +554,17 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				var fld string
-				fld, _ = ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
+				fld := o.fieldName(fe)
+
+				if isDuration(fe.Type()) {
+					return o.translateDuration(ut, fe, "max-duration", Genitive)
 				}
 
-				if idx := strings.Index(fe.Param(), "."); idx != -1 {
-					digits = uint64(len(fe.Param()[idx+1:]))
+				if unit, ok := o.sizeUnit(fe); ok {
+					return o.translateSize(ut, fe, "max-size", unit, Genitive)
 				}
 
-				f64, err := strconv.ParseFloat(fe.Param(), 64)
+				f64, digits, err := parseNumber(fe.Param())
 				if err != nil {
 					goto END
 				}
@@ -351,7 +579,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 
 					var c string
 
-					c, err = ut.C("max-string-character", f64, digits, ut.FmtNumber(f64, digits))
+					c, err = ut.C("max-string-character", f64, digits, formatNumber(f64, digits))
 					if err != nil {
 						goto END
 					}
@@ -361,7 +589,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				case reflect.Slice, reflect.Map, reflect.Array:
 					var c string
 
-					c, err = ut.C("max-items-item", f64, digits, ut.FmtNumber(f64, digits))
+					c, err = ut.C("max-items-item", f64, digits, formatNumber(f64, digits))
 					if err != nil {
 						goto END
 					}
@@ -369,116 +597,130 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 					t, err = ut.T("max-items", fld, c)
 
 				default:
-					t, err = ut.T("max-number", fld, ut.FmtNumber(f64, digits))
+					t, err = ut.T("max-number", fld, o.number(fe, f64, digits))
 				}
 
 			END:
 				if err != nil {
-					fmt.Printf("warning: error translating FieldError: %s", err)
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 
 				return t
 			},
 		},
 		{
-			tag:         "eq",
-			translation: "{0} не равен {1}",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+			Tag: "eq",
+			CustomRegisFunc: o.genderedRegistrationFunc("eq", "Поле {0} должно быть равно {1}", genderForms{
+				masculine: "{0} должен быть равен {1}",
+				feminine:  "{0} должна быть равна {1}",
+				neuter:    "{0} должно быть равно {1}",
+				plural:    "{0} должны быть равны {1}",
+			}, false),
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
 
-				var fld string
-				fld, _ = ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
+				lbl := o.label(fe)
 
-				t, err := ut.T(fe.Tag(), fld, fe.Param())
+				t, err := ut.T(genderKey(fe.Tag(), lbl.Gender), lbl.Name, fe.Param())
 				if err != nil {
-					fmt.Printf("warning: error translating FieldError: %#v", fe)
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 
 				return t
 			},
 		},
 		{
-			tag:         "ne",
-			translation: "Поле {0} должно быть не равно {1}",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+			Tag: "eq_ignore_case",
+			CustomRegisFunc: o.genderedRegistrationFunc("eq_ignore_case", "Поле {0} должно быть равно {1} без учёта регистра", genderForms{
+				masculine: "{0} должен быть равен {1} без учёта регистра",
+				feminine:  "{0} должна быть равна {1} без учёта регистра",
+				neuter:    "{0} должно быть равно {1} без учёта регистра",
+				plural:    "{0} должны быть равны {1} без учёта регистра",
+			}, false),
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
 
-				var fld string
-				fld, _ = ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
+				lbl := o.label(fe)
 
-				t, err := ut.T(fe.Tag(), fld, fe.Param())
+				t, err := ut.T(genderKey(fe.Tag(), lbl.Gender), lbl.Name, fe.Param())
 				if err != nil {
-					fmt.Printf("warning: error translating FieldError: %#v", fe)
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 
 				return t
 			},
 		},
 		{
-			tag: "lt",
-			customRegisFunc: func(ut ut.Translator) (err error) {
+			Tag:         "ne",
+			Translation: "Поле {0} должно быть не равно {1}",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
 
-				if err = ut.Add("lt-string", "Поле {0} должно иметь менее {1}", false); err != nil {
-					return
-				}
+				fld := o.fieldName(fe)
 
-				if err = ut.AddCardinal("lt-string-character", "{0} символ", locales.PluralRuleOne, false); err != nil {
-					return
+				t, err := ut.T(fe.Tag(), fld, fe.Param())
+				if err != nil {
+					return o.fallback(fe, err)
 				}
 
-				if err = ut.AddCardinal("lt-string-character", "{0} символы", locales.PluralRuleOther, false); err != nil {
-					return
-				}
+				return t
+			},
+		},
+		{
+			Tag:         "ne_ignore_case",
+			Translation: "Поле {0} должно быть не равно {1} без учёта регистра",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
 
-				if err = ut.AddCardinal("lt-string-character", "{0} символа", locales.PluralRuleFew, false); err != nil {
-					return
-				}
+				fld := o.fieldName(fe)
 
-				if err = ut.AddCardinal("lt-string-character", "{0} символов", locales.PluralRuleMany, false); err != nil {
-					return
+				t, err := ut.T(fe.Tag(), fld, fe.Param())
+				if err != nil {
+					return o.fallback(fe, err)
 				}
 
-				if err = ut.Add("lt-number", "Поле {0} должно быть менее {1}", false); err != nil {
+				return t
+			},
+		},
+		{
+			Tag: "lt",
+			CustomRegisFunc: func(ut ut.Translator) (err error) {
+
+				if err = ut.Add("lt-string", "Поле {0} должно иметь менее {1}", false); err != nil {
 					return
 				}
 
-				if err = ut.Add("lt-items", "Поле {0} должно содержать менее {1}", false); err != nil {
+				if err = addCardinal(ut, "lt-string-character", charactersGenitive, false); err != nil {
 					return
 				}
 
-				if err = ut.AddCardinal("lt-items-item", "{0} элемент", locales.PluralRuleOne, false); err != nil {
+				if err = ut.Add("lt-number", "Поле {0} должно быть менее {1}", false); err != nil {
 					return
 				}
 
-				if err = ut.AddCardinal("lt-items-item", "{0} элементы", locales.PluralRuleOther, false); err != nil {
+				if err = ut.Add("lt-duration", "Поле {0} должно быть менее {1}", false); err != nil {
 					return
 				}
 
-				if err = ut.AddCardinal("lt-items-item", "{0} элемента", locales.PluralRuleFew, false); err != nil {
+				if err = ut.Add("lt-items", "Поле {0} должно содержать менее {1}", false); err != nil {
 					return
 				}
 
-				if err = ut.AddCardinal("lt-items-item", "{0} элементов", locales.PluralRuleMany, false); err != nil {
+				if err = addCardinal(ut, "lt-items-item", itemsGenitive, false); err != nil {
 					return
 				}
 
-				if err = ut.Add("lt-datetime", "{0} должно быть меньше текущей даты и времени", false); err != nil {
+				if err = o.addGendered(ut, "lt-datetime", "Поле {0} должно быть ранее {1}", genderForms{
+					masculine: "{0} должен быть ранее {1}",
+					feminine:  "{0} должна быть ранее {1}",
+					neuter:    "{0} должно быть ранее {1}",
+					plural:    "{0} должны быть ранее {1}",
+				}, false); err != nil {
 					return
 				}
 
 				return
 
 			},
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
 
 				var err error
 				var t string
@@ -486,19 +728,16 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				var fld string
-				fld, _ = ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
+				lbl := o.label(fe)
+				fld := lbl.Name
+
+				if isDuration(fe.Type()) {
+					return o.translateDuration(ut, fe, "lt-duration", Genitive)
 				}
 
 				fn := func() (err error) {
 
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = parseNumber(fe.Param())
 
 					return
 				}
@@ -518,7 +757,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					c, err = ut.C("lt-string-character", f64, digits, ut.FmtNumber(f64, digits))
+					c, err = ut.C("lt-string-character", f64, digits, formatNumber(f64, digits))
 					if err != nil {
 						goto END
 					}
@@ -533,7 +772,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					c, err = ut.C("lt-items-item", f64, digits, ut.FmtNumber(f64, digits))
+					c, err = ut.C("lt-items-item", f64, digits, formatNumber(f64, digits))
 					if err != nil {
 						goto END
 					}
@@ -546,7 +785,9 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-datetime", fld)
+					// validator compares time.Time fields with the current moment,
+					// ignoring the parameter, so that moment is the bound
+					t, err = ut.T(genderKey("lt-datetime", lbl.Gender), lbl.Name, o.formatTime(now()))
 
 				default:
 					err = fn()
@@ -554,39 +795,26 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lt-number", fld, ut.FmtNumber(f64, digits))
+					t, err = ut.T("lt-number", fld, o.number(fe, f64, digits))
 				}
 
 			END:
 				if err != nil {
-					fmt.Printf("warning: error translating FieldError: %s", err)
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 
 				return t
 			},
 		},
 		{
-			tag: "lte",
-			customRegisFunc: func(ut ut.Translator) (err error) {
+			Tag: "lte",
+			CustomRegisFunc: func(ut ut.Translator) (err error) {
 
 				if err = ut.Add("lte-string", "Поле {0} должно содержать максимум {1}", false); err != nil {
 					return
 				}
 
-				if err = ut.AddCardinal("lte-string-character", "{0} символ", locales.PluralRuleOne, false); err != nil {
-					return
-				}
-
-				if err = ut.AddCardinal("lte-string-character", "{0} символы", locales.PluralRuleOther, false); err != nil {
-					return
-				}
-
-				if err = ut.AddCardinal("lte-string-character", "{0} символа", locales.PluralRuleFew, false); err != nil {
-					return
-				}
-
-				if err = ut.AddCardinal("lte-string-character", "{0} символов", locales.PluralRuleMany, false); err != nil {
+				if err = addCardinal(ut, "lte-string-character", charactersAccusative, false); err != nil {
 					return
 				}
 
@@ -594,33 +822,30 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 					return
 				}
 
-				if err = ut.Add("lte-items", "Поле {0} должно содержать максимум {1}", false); err != nil {
-					return
-				}
-
-				if err = ut.AddCardinal("lte-items-item", "{0} элемент", locales.PluralRuleOne, false); err != nil {
-					return
-				}
-
-				if err = ut.AddCardinal("lte-items-item", "{0} элементы", locales.PluralRuleOther, false); err != nil {
+				if err = ut.Add("lte-duration", "Поле {0} должно быть не более {1}", false); err != nil {
 					return
 				}
 
-				if err = ut.AddCardinal("lte-items-item", "{0} элемента", locales.PluralRuleFew, false); err != nil {
+				if err = ut.Add("lte-items", "Поле {0} должно содержать максимум {1}", false); err != nil {
 					return
 				}
 
-				if err = ut.AddCardinal("lte-items-item", "{0} элементов", locales.PluralRuleMany, false); err != nil {
+				if err = addCardinal(ut, "lte-items-item", itemsAccusative, false); err != nil {
 					return
 				}
 
-				if err = ut.Add("lte-datetime", "{0} должно быть меньше или равно текущей дате и времени", false); err != nil {
+				if err = o.addGendered(ut, "lte-datetime", "Поле {0} должно быть не позднее {1}", genderForms{
+					masculine: "{0} должен быть не позднее {1}",
+					feminine:  "{0} должна быть не позднее {1}",
+					neuter:    "{0} должно быть не позднее {1}",
+					plural:    "{0} должны быть не позднее {1}",
+				}, false); err != nil {
 					return
 				}
 
 				return
 			},
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
 
 				var err error
 				var t string
@@ -628,19 +853,16 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				var fld string
-				fld, _ = ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
+				lbl := o.label(fe)
+				fld := lbl.Name
+
+				if isDuration(fe.Type()) {
+					return o.translateDuration(ut, fe, "lte-duration", Genitive)
 				}
 
 				fn := func() (err error) {
 
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = parseNumber(fe.Param())
 
 					return
 				}
@@ -660,7 +882,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					c, err = ut.C("lte-string-character", f64, digits, ut.FmtNumber(f64, digits))
+					c, err = ut.C("lte-string-character", f64, digits, formatNumber(f64, digits))
 					if err != nil {
 						goto END
 					}
@@ -675,7 +897,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					c, err = ut.C("lte-items-item", f64, digits, ut.FmtNumber(f64, digits))
+					c, err = ut.C("lte-items-item", f64, digits, formatNumber(f64, digits))
 					if err != nil {
 						goto END
 					}
@@ -688,7 +910,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-datetime", fld)
+					t, err = ut.T(genderKey("lte-datetime", lbl.Gender), lbl.Name, o.formatTime(now()))
 
 				default:
 					err = fn()
@@ -696,39 +918,26 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("lte-number", fld, ut.FmtNumber(f64, digits))
+					t, err = ut.T("lte-number", fld, o.number(fe, f64, digits))
 				}
 
 			END:
 				if err != nil {
-					fmt.Printf("warning: error translating FieldError: %s", err)
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 
 				return t
 			},
 		},
 		{
-			tag: "gt",
-			customRegisFunc: func(ut ut.Translator) (err error) {
+			Tag: "gt",
+			CustomRegisFunc: func(ut ut.Translator) (err error) {
 
 				if err = ut.Add("gt-string", "Поле {0} должно быть длиннее {1}", false); err != nil {
 					return
 				}
 
-				if err = ut.AddCardinal("gt-string-character", "{0} символ", locales.PluralRuleOne, false); err != nil {
-					return
-				}
-
-				if err = ut.AddCardinal("gt-string-character", "{0} символы", locales.PluralRuleOther, false); err != nil {
-					return
-				}
-
-				if err = ut.AddCardinal("gt-string-character", "{0} символа", locales.PluralRuleFew, false); err != nil {
-					return
-				}
-
-				if err = ut.AddCardinal("gt-string-character", "{0} символов", locales.PluralRuleMany, false); err != nil {
+				if err = addCardinal(ut, "gt-string-character", charactersGenitive, false); err != nil {
 					return
 				}
 
@@ -736,33 +945,30 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 					return
 				}
 
-				if err = ut.Add("gt-items", "Поле {0} должно содержать более {1}", false); err != nil {
-					return
-				}
-
-				if err = ut.AddCardinal("gt-items-item", "{0} элемент", locales.PluralRuleOne, false); err != nil {
-					return
-				}
-
-				if err = ut.AddCardinal("gt-items-item", "{0} элементы", locales.PluralRuleOther, false); err != nil {
+				if err = ut.Add("gt-duration", "Поле {0} должно быть более {1}", false); err != nil {
 					return
 				}
 
-				if err = ut.AddCardinal("gt-items-item", "{0} элемента", locales.PluralRuleFew, false); err != nil {
+				if err = ut.Add("gt-items", "Поле {0} должно содержать более {1}", false); err != nil {
 					return
 				}
 
-				if err = ut.AddCardinal("gt-items-item", "{0} элементов", locales.PluralRuleMany, false); err != nil {
+				if err = addCardinal(ut, "gt-items-item", itemsGenitive, false); err != nil {
 					return
 				}
 
-				if err = ut.Add("gt-datetime", "{0} должна быть позже текущего момента", false); err != nil {
+				if err = o.addGendered(ut, "gt-datetime", "Поле {0} должно быть позднее {1}", genderForms{
+					masculine: "{0} должен быть позднее {1}",
+					feminine:  "{0} должна быть позднее {1}",
+					neuter:    "{0} должно быть позднее {1}",
+					plural:    "{0} должны быть позднее {1}",
+				}, false); err != nil {
 					return
 				}
 
 				return
 			},
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
 
 				var err error
 				var t string
@@ -770,19 +976,16 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				var fld string
-				fld, _ = ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
+				lbl := o.label(fe)
+				fld := lbl.Name
+
+				if isDuration(fe.Type()) {
+					return o.translateDuration(ut, fe, "gt-duration", Genitive)
 				}
 
 				fn := func() (err error) {
 
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = parseNumber(fe.Param())
 
 					return
 				}
@@ -802,7 +1005,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					c, err = ut.C("gt-string-character", f64, digits, ut.FmtNumber(f64, digits))
+					c, err = ut.C("gt-string-character", f64, digits, formatNumber(f64, digits))
 					if err != nil {
 						goto END
 					}
@@ -817,7 +1020,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					c, err = ut.C("gt-items-item", f64, digits, ut.FmtNumber(f64, digits))
+					c, err = ut.C("gt-items-item", f64, digits, formatNumber(f64, digits))
 					if err != nil {
 						goto END
 					}
@@ -830,7 +1033,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-datetime", fld)
+					t, err = ut.T(genderKey("gt-datetime", lbl.Gender), lbl.Name, o.formatTime(now()))
 
 				default:
 					err = fn()
@@ -838,73 +1041,57 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gt-number", fld, ut.FmtNumber(f64, digits))
+					t, err = ut.T("gt-number", fld, o.number(fe, f64, digits))
 				}
 
 			END:
 				if err != nil {
-					fmt.Printf("warning: error translating FieldError: %s", err)
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 
 				return t
 			},
 		},
 		{
-			tag: "gte",
-			customRegisFunc: func(ut ut.Translator) (err error) {
+			Tag: "gte",
+			CustomRegisFunc: func(ut ut.Translator) (err error) {
 
 				if err = ut.Add("gte-string", "Поле {0} должно содержать минимум {1}", false); err != nil {
 					return
 				}
 
-				if err = ut.AddCardinal("gte-string-character", "{0} символ", locales.PluralRuleOne, false); err != nil {
+				if err = addCardinal(ut, "gte-string-character", charactersAccusative, false); err != nil {
 					return
 				}
 
-				if err = ut.AddCardinal("gte-string-character", "{0} символы", locales.PluralRuleOther, false); err != nil {
+				if err = ut.Add("gte-number", "Поле {0} должно быть больше или равно {1}", false); err != nil {
 					return
 				}
 
-				if err = ut.AddCardinal("gte-string-character", "{0} символа", locales.PluralRuleFew, false); err != nil {
+				if err = ut.Add("gte-duration", "Поле {0} должно быть не менее {1}", false); err != nil {
 					return
 				}
 
-				if err = ut.AddCardinal("gte-string-character", "{0} символов", locales.PluralRuleMany, false); err != nil {
+				if err = ut.Add("gte-items", "Поле {0} должно содержать минимум {1}", false); err != nil {
 					return
 				}
 
-				if err = ut.Add("gte-number", "Поле {0} должно быть больше или равно {1}", false); err != nil {
+				if err = addCardinal(ut, "gte-items-item", itemsAccusative, false); err != nil {
 					return
 				}
 
-				if err = ut.Add("gte-items", "Поле {0} должно содержать минимум {1}", false); err != nil {
-					return
-				}
-
-				if err = ut.AddCardinal("gte-items-item", "{0} элемент", locales.PluralRuleOne, false); err != nil {
-					return
-				}
-
-				if err = ut.AddCardinal("gte-items-item", "{0} элементы", locales.PluralRuleOther, false); err != nil {
-					return
-				}
-
-				if err = ut.AddCardinal("gte-items-item", "{0} элемента", locales.PluralRuleFew, false); err != nil {
-					return
-				}
-
-				if err = ut.AddCardinal("gte-items-item", "{0} элементов", locales.PluralRuleMany, false); err != nil {
-					return
-				}
-
-				if err = ut.Add("gte-datetime", "{0} должна быть позже или равна текущему моменту", false); err != nil {
+				if err = o.addGendered(ut, "gte-datetime", "Поле {0} должно быть не ранее {1}", genderForms{
+					masculine: "{0} должен быть не ранее {1}",
+					feminine:  "{0} должна быть не ранее {1}",
+					neuter:    "{0} должно быть не ранее {1}",
+					plural:    "{0} должны быть не ранее {1}",
+				}, false); err != nil {
 					return
 				}
 
 				return
 			},
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
 
 				var err error
 				var t string
@@ -912,19 +1099,16 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				var digits uint64
 				var kind reflect.Kind
 
-				var fld string
-				fld, _ = ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
+				lbl := o.label(fe)
+				fld := lbl.Name
+
+				if isDuration(fe.Type()) {
+					return o.translateDuration(ut, fe, "gte-duration", Genitive)
 				}
 
 				fn := func() (err error) {
 
-					if idx := strings.Index(fe.Param(), "."); idx != -1 {
-						digits = uint64(len(fe.Param()[idx+1:]))
-					}
-
-					f64, err = strconv.ParseFloat(fe.Param(), 64)
+					f64, digits, err = parseNumber(fe.Param())
 
 					return
 				}
@@ -944,7 +1128,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					c, err = ut.C("gte-string-character", f64, digits, ut.FmtNumber(f64, digits))
+					c, err = ut.C("gte-string-character", f64, digits, formatNumber(f64, digits))
 					if err != nil {
 						goto END
 					}
@@ -959,7 +1143,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					c, err = ut.C("gte-items-item", f64, digits, ut.FmtNumber(f64, digits))
+					c, err = ut.C("gte-items-item", f64, digits, formatNumber(f64, digits))
 					if err != nil {
 						goto END
 					}
@@ -972,7 +1156,7 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-datetime", fld)
+					t, err = ut.T(genderKey("gte-datetime", lbl.Gender), lbl.Name, o.formatTime(now()))
 
 				default:
 					err = fn()
@@ -980,1351 +1164,1994 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 						goto END
 					}
 
-					t, err = ut.T("gte-number", fld, ut.FmtNumber(f64, digits))
+					t, err = ut.T("gte-number", fld, o.number(fe, f64, digits))
 				}
 
 			END:
 				if err != nil {
-					fmt.Printf("warning: error translating FieldError: %s", err)
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 
 				return t
 			},
 		},
 		{
-			tag:         "eqfield",
-			translation: "Поле {0} должно быть равно {1}",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-
-				var fld string
-				fld, _ = ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
+			Tag:         "eqfield",
+			Translation: "Поле {0} должно быть равно {1:dat}",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
 
-				t, err := ut.T(fe.Tag(), fld, fe.Param())
+				t, err := o.t(ut, fe.Tag(), o.label(fe), o.paramLabel(fe))
 				if err != nil {
-					log.Printf("warning: error translating FieldError: %#v", fe)
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 
 				return t
 			},
 		},
 		{
-			tag:         "eqcsfield",
-			translation: "Поле {0} должно быть равно {1}",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-
-				var fld string
-				fld, _ = ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
+			Tag:         "eqcsfield",
+			Translation: "Поле {0} должно быть равно {1:dat}",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
 
-				t, err := ut.T(fe.Tag(), fld, fe.Param())
+				t, err := o.t(ut, fe.Tag(), o.label(fe), o.paramLabel(fe))
 				if err != nil {
-					log.Printf("warning: error translating FieldError: %#v", fe)
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 
 				return t
 			},
 		},
 		{
-			tag:         "necsfield",
-			translation: "{0} не должен быть равно {1}",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+			Tag: "necsfield",
+			CustomRegisFunc: o.genderedRegistrationFunc("necsfield", "Поле {0} не должно быть равно {1:dat}", genderForms{
+				masculine: "{0} не должен быть равен {1:dat}",
+				feminine:  "{0} не должна быть равна {1:dat}",
+				neuter:    "{0} не должно быть равно {1:dat}",
+				plural:    "{0} не должны быть равны {1:dat}",
+			}, false),
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
 
-				var fld string
-				fld, _ = ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
+				lbl := o.label(fe)
 
-				t, err := ut.T(fe.Tag(), fld, fe.Param())
+				t, err := o.t(ut, genderKey(fe.Tag(), lbl.Gender), lbl, o.paramLabel(fe))
 				if err != nil {
-					log.Printf("warning: error translating FieldError: %#v", fe)
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 
 				return t
 			},
 		},
 		{
-			tag:         "gtcsfield",
-			translation: "Поле {0} должно быть больше {1}",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-
-				var fld string
-				fld, _ = ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
+			Tag:         "gtcsfield",
+			Translation: "Поле {0} должно быть больше {1:gen}",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
 
-				t, err := ut.T(fe.Tag(), fld, fe.Param())
+				t, err := o.t(ut, fe.Tag(), o.label(fe), o.paramLabel(fe))
 				if err != nil {
-					log.Printf("warning: error translating FieldError: %#v", fe)
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 
 				return t
 			},
 		},
 		{
-			tag:         "gtecsfield",
-			translation: "Поле {0} должно быть больше или равно {1}",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-
-				var fld string
-				fld, _ = ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
+			Tag:         "gtecsfield",
+			Translation: "Поле {0} должно быть больше или равно {1:gen}",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
 
-				t, err := ut.T(fe.Tag(), fld, fe.Param())
+				t, err := o.t(ut, fe.Tag(), o.label(fe), o.paramLabel(fe))
 				if err != nil {
-					log.Printf("warning: error translating FieldError: %#v", fe)
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 
 				return t
 			},
 		},
 		{
-			tag:         "ltcsfield",
-			translation: "Поле {0} должно быть менее {1}",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+			Tag:         "ltcsfield",
+			Translation: "Поле {0} должно быть менее {1:gen}",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
 
-				var fld string
-				fld, _ = ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
-
-				t, err := ut.T(fe.Tag(), fld, fe.Param())
+				t, err := o.t(ut, fe.Tag(), o.label(fe), o.paramLabel(fe))
 				if err != nil {
-					log.Printf("warning: error translating FieldError: %#v", fe)
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 
 				return t
 			},
 		},
 		{
-			tag:         "ltecsfield",
-			translation: "Поле {0} должно быть менее или равно {1}",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-
-				var fld string
-				fld, _ = ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
+			Tag:         "ltecsfield",
+			Translation: "Поле {0} должно быть менее или равно {1:gen}",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
 
-				t, err := ut.T(fe.Tag(), fld, fe.Param())
+				t, err := o.t(ut, fe.Tag(), o.label(fe), o.paramLabel(fe))
 				if err != nil {
-					log.Printf("warning: error translating FieldError: %#v", fe)
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 
 				return t
 			},
 		},
 		{
-			tag:         "nefield",
-			translation: "Поле {0} не должен быть равно {1}",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+			Tag: "nefield",
+			CustomRegisFunc: o.genderedRegistrationFunc("nefield", "Поле {0} не должно быть равно {1:dat}", genderForms{
+				masculine: "{0} не должен быть равен {1:dat}",
+				feminine:  "{0} не должна быть равна {1:dat}",
+				neuter:    "{0} не должно быть равно {1:dat}",
+				plural:    "{0} не должны быть равны {1:dat}",
+			}, false),
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
 
-				var fld string
-				fld, _ = ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
+				lbl := o.label(fe)
 
-				t, err := ut.T(fe.Tag(), fld, fe.Param())
+				t, err := o.t(ut, genderKey(fe.Tag(), lbl.Gender), lbl, o.paramLabel(fe))
 				if err != nil {
-					log.Printf("warning: error translating FieldError: %#v", fe)
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 
 				return t
 			},
 		},
 		{
-			tag:         "gtfield",
-			translation: "Поле {0} должно быть больше {1}",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+			Tag:         "gtfield",
+			Translation: "Поле {0} должно быть больше {1:gen}",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
 
-				var fld string
-				fld, _ = ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
-
-				t, err := ut.T(fe.Tag(), fld, fe.Param())
+				t, err := o.t(ut, fe.Tag(), o.label(fe), o.paramLabel(fe))
 				if err != nil {
-					log.Printf("warning: error translating FieldError: %#v", fe)
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 
 				return t
 			},
 		},
 		{
-			tag:         "gtefield",
-			translation: "Поле {0} должно быть больше или равно {1}",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+			Tag:         "gtefield",
+			Translation: "Поле {0} должно быть больше или равно {1:gen}",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
 
-				var fld string
-				fld, _ = ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
+				t, err := o.t(ut, fe.Tag(), o.label(fe), o.paramLabel(fe))
+				if err != nil {
+					return o.fallback(fe, err)
 				}
 
-				t, err := ut.T(fe.Tag(), fld, fe.Param())
+				return t
+			},
+		},
+		{
+			Tag:         "ltfield",
+			Translation: "Поле {0} должно быть менее {1:gen}",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := o.t(ut, fe.Tag(), o.label(fe), o.paramLabel(fe))
 				if err != nil {
-					log.Printf("warning: error translating FieldError: %#v", fe)
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 
 				return t
 			},
 		},
 		{
-			tag:         "ltfield",
-			translation: "Поле {0} должно быть менее {1}",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+			Tag:         "ltefield",
+			Translation: "Поле {0} должно быть менее или равно {1:gen}",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
 
-				var fld string
-				fld, _ = ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
+				t, err := o.t(ut, fe.Tag(), o.label(fe), o.paramLabel(fe))
+				if err != nil {
+					return o.fallback(fe, err)
 				}
 
-				t, err := ut.T(fe.Tag(), fld, fe.Param())
+				return t
+			},
+		},
+		{
+			Tag:         "alpha",
+			Translation: "Поле {0} должно содержать только буквы",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					log.Printf("warning: error translating FieldError: %#v", fe)
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
-
 				return t
 			},
 		},
 		{
-			tag:         "ltefield",
-			translation: "Поле {0} должно быть менее или равно {1}",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-
-				var fld string
-				fld, _ = ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
+			Tag:         "alphanum",
+			Translation: "Поле {0} должно содержать только буквы и цифры",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
 				}
-
-				t, err := ut.T(fe.Tag(), fld, fe.Param())
+				return t
+			},
+		},
+		{
+			Tag:         "numeric",
+			Translation: "Поле {0} должно быть цифровым значением",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					log.Printf("warning: error translating FieldError: %#v", fe)
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
-
 				return t
 			},
 		},
 		{
-			tag:         "alpha",
-			translation: "Поле {0} должно содержать только буквы",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
+			Tag:         "boolean",
+			Translation: "Поле {0} должно быть логическим значением (true или false)",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
 				}
+				return t
+			},
+		},
+		{
+			Tag:         "number",
+			Translation: "Поле {0} должно быть цифрой",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
 				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag:         "alphanum",
-			translation: "Поле {0} должно содержать только буквы и цифры",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
+			Tag:         "hexadecimal",
+			Translation: "Поле {0} должно быть шестнадцатеричной строкой",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
 				}
+				return t
+			},
+		},
+		{
+			Tag:         "hexcolor",
+			Translation: "Поле {0} должно быть HEX цветом",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
 				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag:         "numeric",
-			translation: "Поле {0} должно быть цифровым значением",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
+			Tag:         "rgb",
+			Translation: "Поле {0} должно быть RGB цветом",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
 				}
+				return t
+			},
+		},
+		{
+			Tag:         "rgba",
+			Translation: "Поле {0} должно быть RGBA цветом",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
 				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag:         "number",
-			translation: "Поле {0} должно быть цифрой",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
+			Tag:         "hsl",
+			Translation: "Поле {0} должно быть HSL цветом",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
 				}
+				return t
+			},
+		},
+		{
+			Tag:         "hsla",
+			Translation: "Поле {0} должно быть HSLA цветом",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
 				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag:         "hexadecimal",
-			translation: "Поле {0} должно быть шестнадцатеричной строкой",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
+			Tag:         "e164",
+			Translation: "Поле {0} должно быть номером телефона в формате E.164",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
 				}
+				return t
+			},
+		},
+		{
+			Tag:         "email",
+			Translation: "Поле {0} должно быть email адресом",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
 				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag:         "hexcolor",
-			translation: "Поле {0} должно быть HEX цветом",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
+			Tag:         "url",
+			Translation: "Поле {0} должно быть URL",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
 				}
+				return t
+			},
+		},
+		{
+			Tag:         "uri",
+			Translation: "Поле {0} должно быть URI",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
 				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag:         "rgb",
-			translation: "Поле {0} должно быть RGB цветом",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
+			Tag:         "base64",
+			Translation: "Поле {0} должно быть Base64 строкой",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
 				}
+				return t
+			},
+		},
+		{
+			Tag:         "base32",
+			Translation: "Поле {0} должно быть Base32 строкой",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
 				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag:         "rgba",
-			translation: "Поле {0} должно быть RGBA цветом",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
+			Tag:         "base64url",
+			Translation: "Поле {0} должно быть Base64URL строкой",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
 				}
+				return t
+			},
+		},
+		{
+			Tag:         "base64rawurl",
+			Translation: "Поле {0} должно быть Base64URL строкой без дополнения",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
 				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag:         "hsl",
-			translation: "Поле {0} должно быть HSL цветом",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
+			Tag:         "md4",
+			Translation: "Поле {0} должно быть хешем MD4",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
 				}
+				return t
+			},
+		},
+		{
+			Tag:         "md5",
+			Translation: "Поле {0} должно быть хешем MD5",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
 				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag:         "hsla",
-			translation: "Поле {0} должно быть HSLA цветом",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
+			Tag:         "sha256",
+			Translation: "Поле {0} должно быть хешем SHA-256",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
 				}
+				return t
+			},
+		},
+		{
+			Tag:         "sha384",
+			Translation: "Поле {0} должно быть хешем SHA-384",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
 				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag:         "e164",
-			translation: "Поле {0} должно быть E.164 formatted phone number",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
+			Tag:         "sha512",
+			Translation: "Поле {0} должно быть хешем SHA-512",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
 				}
+				return t
+			},
+		},
+		{
+			Tag:         "ripemd128",
+			Translation: "Поле {0} должно быть хешем RIPEMD-128",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
 				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag:         "email",
-			translation: "Поле {0} должно быть email адресом",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
+			Tag:         "ripemd160",
+			Translation: "Поле {0} должно быть хешем RIPEMD-160",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
 				}
+				return t
+			},
+		},
+		{
+			Tag:         "tiger128",
+			Translation: "Поле {0} должно быть хешем Tiger-128",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
 				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag:         "url",
-			translation: "Поле {0} должно быть URL",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
+			Tag:         "tiger160",
+			Translation: "Поле {0} должно быть хешем Tiger-160",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
 				}
+				return t
+			},
+		},
+		{
+			Tag:         "tiger192",
+			Translation: "Поле {0} должно быть хешем Tiger-192",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
 				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag:         "uri",
-			translation: "Поле {0} должно быть URI",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
+			Tag:         "html",
+			Translation: "Поле {0} должно содержать HTML-разметку",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
 				}
+				return t
+			},
+		},
+		{
+			Tag:         "html_encoded",
+			Translation: "Поле {0} должно быть закодировано для HTML",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
 				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag:         "base64",
-			translation: "Поле {0} должно быть Base64 строкой",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
+			Tag:         "json",
+			Translation: "Поле {0} должно быть корректным JSON",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
 				}
+				return t
+			},
+		},
+		{
+			Tag:         "jwt",
+			Translation: "Поле {0} должно быть JWT-токеном",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
 				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag:         "contains",
-			translation: "Поле {0} должно содержать текст '{1}'",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+			Tag:         "contains",
+			Translation: "Поле {0} должно содержать текст '{1}'",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
 
-				var fld string
-				fld, _ = ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
+				fld := o.fieldName(fe)
 
 				t, err := ut.T(fe.Tag(), fld, fe.Param())
 				if err != nil {
-					log.Printf("warning: error translating FieldError: %#v", fe)
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 
 				return t
 			},
 		},
 		{
-			tag:         "containsany",
-			translation: "Поле {0} должно содержать минимум один из символов '{1}'",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+			Tag:         "containsany",
+			Translation: "Поле {0} должно содержать минимум один из символов '{1}'",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
 
-				var fld string
-				fld, _ = ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
+				fld := o.fieldName(fe)
 
 				t, err := ut.T(fe.Tag(), fld, fe.Param())
 				if err != nil {
-					log.Printf("warning: error translating FieldError: %#v", fe)
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 
 				return t
 			},
 		},
 		{
-			tag:         "excludes",
-			translation: "Поле {0} не должно содержать текст '{1}'",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+			Tag:         "excludes",
+			Translation: "Поле {0} не должно содержать текст '{1}'",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
 
-				var fld string
-				fld, _ = ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
+				fld := o.fieldName(fe)
 
 				t, err := ut.T(fe.Tag(), fld, fe.Param())
 				if err != nil {
-					log.Printf("warning: error translating FieldError: %#v", fe)
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 
 				return t
 			},
 		},
 		{
-			tag:         "excludesall",
-			translation: "Поле {0} не должно содержать символы '{1}'",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+			Tag:         "excludesall",
+			Translation: "Поле {0} не должно содержать символы '{1}'",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
 
-				var fld string
-				fld, _ = ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
+				fld := o.fieldName(fe)
 
 				t, err := ut.T(fe.Tag(), fld, fe.Param())
 				if err != nil {
-					log.Printf("warning: error translating FieldError: %#v", fe)
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 
 				return t
 			},
 		},
 		{
-			tag:         "excludesrune",
-			translation: "Поле {0} не должно содержать '{1}'",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+			Tag:         "excludesrune",
+			Translation: "Поле {0} не должно содержать '{1}'",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
 
-				var fld string
-				fld, _ = ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
+				fld := o.fieldName(fe)
 
 				t, err := ut.T(fe.Tag(), fld, fe.Param())
 				if err != nil {
-					log.Printf("warning: error translating FieldError: %#v", fe)
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 
 				return t
 			},
 		},
 		{
-			tag:         "isbn",
-			translation: "Поле {0} должно быть ISBN номером",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
-				t, err := ut.T(fe.Tag(), fld)
+			Tag:         "fieldcontains",
+			Translation: "Поле {0} должно содержать значение поля {1}",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := o.t(ut, fe.Tag(), o.label(fe), o.paramLabel(fe))
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
+
 				return t
 			},
 		},
 		{
-			tag:         "isbn10",
-			translation: "Поле {0} должно быть ISBN-10 номером",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
+			Tag:         "fieldexcludes",
+			Translation: "Поле {0} не должно содержать значение поля {1}",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := o.t(ut, fe.Tag(), o.label(fe), o.paramLabel(fe))
+				if err != nil {
+					return o.fallback(fe, err)
 				}
-				t, err := ut.T(fe.Tag(), fld)
+
+				return t
+			},
+		},
+		{
+			Tag:         "startswith",
+			Translation: "Поле {0} должно начинаться с «{1}»",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				fld := o.fieldName(fe)
+
+				t, err := ut.T(fe.Tag(), fld, fe.Param())
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
+
 				return t
 			},
 		},
 		{
-			tag:         "isbn13",
-			translation: "Поле {0} должно быть ISBN-13 номером",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
+			Tag:         "endswith",
+			Translation: "Поле {0} должно заканчиваться на «{1}»",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				fld := o.fieldName(fe)
+
+				t, err := ut.T(fe.Tag(), fld, fe.Param())
+				if err != nil {
+					return o.fallback(fe, err)
 				}
-				t, err := ut.T(fe.Tag(), fld)
+
+				return t
+			},
+		},
+		{
+			Tag:         "startsnotwith",
+			Translation: "Поле {0} не должно начинаться с «{1}»",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				fld := o.fieldName(fe)
+
+				t, err := ut.T(fe.Tag(), fld, fe.Param())
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
+
 				return t
 			},
 		},
 		{
-			tag:         "uuid",
-			translation: "Поле {0} должно быть UUID",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
+			Tag:         "endsnotwith",
+			Translation: "Поле {0} не должно заканчиваться на «{1}»",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				fld := o.fieldName(fe)
+
+				t, err := ut.T(fe.Tag(), fld, fe.Param())
+				if err != nil {
+					return o.fallback(fe, err)
 				}
+
+				return t
+			},
+		},
+		{
+			Tag:         "lowercase",
+			Translation: "Поле {0} должно быть в нижнем регистре",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
 				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag:         "uuid3",
-			translation: "Поле {0} должно быть UUID 3 версии",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
+			Tag:         "uppercase",
+			Translation: "Поле {0} должно быть в верхнем регистре",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
 				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag:         "uuid4",
-			translation: "Поле {0} должно быть UUID 4 версии",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
+			Tag:         "isbn",
+			Translation: "Поле {0} должно быть ISBN номером",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
 				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag:         "uuid5",
-			translation: "Поле {0} должно быть UUID 5 версии",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
+			Tag:         "isbn10",
+			Translation: "Поле {0} должно быть ISBN-10 номером",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
 				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag:         "ascii",
-			translation: "Поле {0} должно содержать только ascii символы",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
+			Tag:         "isbn13",
+			Translation: "Поле {0} должно быть ISBN-13 номером",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
 				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag:         "printascii",
-			translation: "Поле {0} должно содержать только доступные для печати ascii символы",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
+			Tag:         "uuid",
+			Translation: "Поле {0} должно быть UUID",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
 				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag:         "multibyte",
-			translation: "Поле {0} должно содержать мультибайтные символы",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
+			Tag:         "uuid3",
+			Translation: "Поле {0} должно быть UUID 3 версии",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
 				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag:         "datauri",
-			translation: "Поле {0} должно содержать Data URI",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
+			Tag:         "uuid4",
+			Translation: "Поле {0} должно быть UUID 4 версии",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
 				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag:         "latitude",
-			translation: "Поле {0} должно содержать координаты широты",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
+			Tag:         "uuid5",
+			Translation: "Поле {0} должно быть UUID 5 версии",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
 				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag:         "longitude",
-			translation: "Поле {0} должно содержать координаты долготы",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
+			Tag:         "uuid_rfc4122",
+			Translation: "Поле {0} должно быть UUID в формате RFC 4122",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
 				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag:         "ssn",
-			translation: "Поле {0} должно быть SSN номером",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
+			Tag:         "uuid3_rfc4122",
+			Translation: "Поле {0} должно быть UUID 3 версии в формате RFC 4122",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
 				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag:         "ipv4",
-			translation: "Поле {0} должно быть IPv4 адресом",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
+			Tag:         "uuid4_rfc4122",
+			Translation: "Поле {0} должно быть UUID 4 версии в формате RFC 4122",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
 				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag:         "ipv6",
-			translation: "Поле {0} должно быть IPv6 адресом",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
+			Tag:         "uuid5_rfc4122",
+			Translation: "Поле {0} должно быть UUID 5 версии в формате RFC 4122",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
 				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag:         "ip",
-			translation: "Поле {0} должно быть IP адресом",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
+			Tag:         "ulid",
+			Translation: "Поле {0} должно быть ULID",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
 				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag:         "cidr",
-			translation: "Поле {0} должно содержать CIDR обозначения",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
+			Tag:         "mongodb",
+			Translation: "Поле {0} должно быть идентификатором ObjectId MongoDB",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
 				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag:         "cidrv4",
-			translation: "Поле {0} должно содержать CIDR обозначения для IPv4 адреса",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
+			Tag:         "mongodb_connection_string",
+			Translation: "Поле {0} должно быть строкой подключения к MongoDB",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
 				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag:         "cidrv6",
-			translation: "Поле {0} должно содержать CIDR обозначения для IPv6 адреса",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
+			Tag:         "cve",
+			Translation: "Поле {0} должно быть идентификатором CVE",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
 				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag:         "tcp_addr",
-			translation: "Поле {0} должно быть TCP адресом",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
+			Tag:         "semver",
+			Translation: "Поле {0} должно быть версией в формате SemVer",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
 				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag:         "tcp4_addr",
-			translation: "Поле {0} должно быть IPv4 TCP адресом",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
+			Tag:         "cron",
+			Translation: "Поле {0} должно быть cron-выражением",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
 				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag:         "tcp6_addr",
-			translation: "Поле {0} должно быть IPv6 TCP адресом",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
+			Tag: "spicedb",
+			CustomRegisFunc: func(ut ut.Translator) (err error) {
+
+				if err = ut.Add("spicedb-id", "Поле {0} должно быть идентификатором объекта SpiceDB", false); err != nil {
+					return
 				}
-				t, err := ut.T(fe.Tag(), fld)
+
+				if err = ut.Add("spicedb-permission", "Поле {0} должно быть названием разрешения SpiceDB", false); err != nil {
+					return
+				}
+
+				if err = ut.Add("spicedb-type", "Поле {0} должно быть типом объекта SpiceDB", false); err != nil {
+					return
+				}
+
+				return
+			},
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				fld := o.fieldName(fe)
+
+				kind := fe.Param()
+				if kind == "" {
+					kind = "id"
+				}
+
+				t, err := ut.T("spicedb-"+kind, fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
+
 				return t
 			},
 		},
 		{
-			tag:         "udp_addr",
-			translation: "Поле {0} должно быть UDP адресом",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
+			Tag:         "ascii",
+			Translation: "Поле {0} должно содержать только ascii символы",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
 				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag:         "udp4_addr",
-			translation: "Поле {0} должно быть IPv4 UDP адресом",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
+			Tag:         "printascii",
+			Translation: "Поле {0} должно содержать только доступные для печати ascii символы",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
 				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag:         "udp6_addr",
-			translation: "Поле {0} должно быть IPv6 UDP адресом",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
+			Tag:         "multibyte",
+			Translation: "Поле {0} должно содержать мультибайтные символы",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
 				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag:         "ip_addr",
-			translation: "Поле {0} должно быть распознаваемым IP адресом",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
+			Tag:         "datauri",
+			Translation: "Поле {0} должно содержать Data URI",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
 				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag:         "ip4_addr",
-			translation: "Поле {0} должно быть распознаваемым IPv4 адресом",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
+			Tag:         "latitude",
+			Translation: "Поле {0} должно содержать координаты широты",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
 				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag:         "ip6_addr",
-			translation: "Поле {0} должно быть распознаваемым IPv6 адресом",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
+			Tag:         "longitude",
+			Translation: "Поле {0} должно содержать координаты долготы",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
 				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag:         "unix_addr",
-			translation: "Поле {0} должно быть распознаваемым UNIX адресом",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
+			Tag:         "iso3166_1_alpha2",
+			Translation: "Поле {0} должно быть двухбуквенным кодом страны ISO 3166-1 (например, RU)",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
 				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag:         "mac",
-			translation: "Поле {0} должно содержать MAC адрес",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
+			Tag:         "iso3166_1_alpha2_eu",
+			Translation: "Поле {0} должно быть двухбуквенным кодом страны Евросоюза ISO 3166-1 (например, DE)",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
 				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag:         "unique",
-			translation: "Поле {0} должно содержать уникальные значения",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
+			Tag:         "iso3166_1_alpha3",
+			Translation: "Поле {0} должно быть трёхбуквенным кодом страны ISO 3166-1 (например, RUS)",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
 				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag:         "iscolor",
-			translation: "Поле {0} должно быть цветом",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-				fld, _ := ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
+			Tag:         "iso3166_1_alpha3_eu",
+			Translation: "Поле {0} должно быть трёхбуквенным кодом страны Евросоюза ISO 3166-1 (например, DEU)",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
 				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
 				return t
 			},
 		},
 		{
-			tag:         "oneof",
-			translation: "Поле {0} должно быть одним из [{1}]",
-			override:    false,
-			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
-
-				var fld string
-				fld, _ = ut.T(fe.Field())
-				if fld == "" {
-					fld = fe.Field()
-				}
-
-				s, err := ut.T(fe.Tag(), fld, fe.Param())
+			Tag:         "iso3166_1_alpha_numeric",
+			Translation: "Поле {0} должно быть цифровым кодом страны ISO 3166-1 (например, 643)",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
 				if err != nil {
-					log.Printf("warning: error translating FieldError: %#v", fe)
-					return fe.(error).Error()
+					return o.fallback(fe, err)
 				}
-				return s
+				return t
 			},
 		},
 		{
-			tag:         "dateInFuture",
-			translation: "Дата и время не могут быть в прошлом",
-			override:    false,
-		},
+			Tag:         "iso3166_1_alpha_numeric_eu",
+			Translation: "Поле {0} должно быть цифровым кодом страны Евросоюза ISO 3166-1 (например, 276)",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
+		},
 		{
-			tag:         "existedEventsParams",
-			translation: "Недопустимые параметры события",
-			override:    false,
+			Tag:         "iso3166_2",
+			Translation: "Поле {0} должно быть кодом региона ISO 3166-2 (например, RU-MOW)",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
 		},
 		{
-			tag:         "fileAccessType",
-			translation: "Недопустимый тип доступа к файлу",
-			override:    false,
+			Tag:         "postcode_iso3166_alpha2",
+			Translation: "Поле {0} не является почтовым индексом для страны {1}",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				fld := o.fieldName(fe)
+
+				t, err := ut.T(fe.Tag(), fld, countryName(fe.Param()))
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+
+				return t
+			},
 		},
 		{
-			tag:         "starRating",
-			translation: "Недопустимая оценка",
-			override:    false,
+			Tag:         "postcode_iso3166_alpha2_field",
+			Translation: "Поле {0} не является почтовым индексом для страны, указанной в поле {1}",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := o.t(ut, fe.Tag(), o.label(fe), o.paramLabel(fe))
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+
+				return t
+			},
 		},
 		{
-			tag:         "userExistsInLdap",
-			translation: "Пользователь не найден в LDAP",
-			override:    false,
+			Tag:         "bcp47_language_tag",
+			Translation: "Поле {0} должно быть тегом языка BCP 47 (например, ru-RU)",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
 		},
 		{
-			tag:         "Title",
-			translation: "Название",
-			override:    false,
+			Tag:         "timezone",
+			Translation: "Поле {0} должно быть часовым поясом из базы IANA (например, Europe/Moscow)",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
 		},
 		{
-			tag:         "Description",
-			translation: "Описание",
-			override:    false,
+			Tag: "datetime",
+			CustomRegisFunc: func(ut ut.Translator) (err error) {
+
+				if err = ut.Add("datetime", "Поле {0} должно быть в формате {1}, например {2}", false); err != nil {
+					return
+				}
+
+				// the example of layouts with month or weekday names would be
+				// in English
+				if err = ut.Add("datetime-names", "Поле {0} должно быть в формате {1}", false); err != nil {
+					return
+				}
+
+				return
+			},
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				var t string
+				var err error
+
+				fld := o.fieldName(fe)
+				layout := fe.Param()
+
+				desc, names := describeLayout(layout)
+				if names {
+					t, err = ut.T("datetime-names", fld, desc)
+				} else {
+					t, err = ut.T(fe.Tag(), fld, desc, now().Format(layout))
+				}
+
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+
+				return t
+			},
 		},
 		{
-			tag:         "FileName",
-			translation: "Имя файла",
-			override:    false,
+			Tag:         "ssn",
+			Translation: "Поле {0} должно быть SSN номером",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
 		},
 		{
-			tag:         "FileAccess",
-			translation: "Доступ файла",
-			override:    false,
+			Tag:         "credit_card",
+			Translation: "Поле {0} должно быть номером банковской карты",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
 		},
 		{
-			tag:         "StartAt",
-			translation: "Время начала",
-			override:    false,
+			Tag:         "luhn_checksum",
+			Translation: "Поле {0} должно иметь корректную контрольную сумму по алгоритму Луна",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
 		},
 		{
-			tag:         "EndAt",
-			translation: "Время окончания",
-			override:    false,
+			Tag:         "iso4217",
+			Translation: "Поле {0} должно быть кодом валюты ISO 4217 (например, RUB)",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
 		},
 		{
-			tag:         "EventID",
-			translation: "ID события",
-			override:    false,
+			Tag:         "iso4217_numeric",
+			Translation: "Поле {0} должно быть цифровым кодом валюты ISO 4217 (например, 643)",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
 		},
 		{
-			tag:         "UserID",
-			translation: "ID пользователя",
-			override:    false,
+			Tag:         "bic",
+			Translation: "Поле {0} должно быть BIC (SWIFT) кодом банка",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
 		},
 		{
-			tag:         "Message",
-			translation: "Сообщение",
-			override:    false,
+			Tag:         "bic_iso_9362_2014",
+			Translation: "Поле {0} должно быть BIC (SWIFT) кодом банка по ISO 9362:2014",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
 		},
 		{
-			tag:         "Page",
-			translation: "Страница",
-			override:    false,
+			Tag:         "btc_addr",
+			Translation: "Поле {0} должно быть адресом Bitcoin",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
 		},
 		{
-			tag:         "Limit",
-			translation: "Лимит",
-			override:    false,
+			Tag:         "btc_addr_bech32",
+			Translation: "Поле {0} должно быть адресом Bitcoin в формате Bech32",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
 		},
 		{
-			tag:         "Source",
-			translation: "Источник",
-			override:    false,
+			Tag:         "eth_addr",
+			Translation: "Поле {0} должно быть адресом Ethereum",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
 		},
 		{
-			tag:         "ModeratorEmails",
-			translation: "Модераторы",
-			override:    false,
+			Tag:         "eth_addr_checksum",
+			Translation: "Поле {0} должно быть адресом Ethereum с контрольной суммой",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
 		},
 		{
-			tag:         "Params",
-			translation: "Параметры",
-			override:    false,
+			Tag:         "ipv4",
+			Translation: "Поле {0} должно быть IPv4 адресом",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
 		},
 		{
-			tag:         "Device",
-			translation: "Устройство",
-			override:    false,
+			Tag:         "ipv6",
+			Translation: "Поле {0} должно быть IPv6 адресом",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
 		},
 		{
-			tag:         "Os",
-			translation: "Операционная система",
-			override:    false,
+			Tag:         "ip",
+			Translation: "Поле {0} должно быть IP адресом",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
 		},
 		{
-			tag:         "Browser",
-			translation: "Браузер",
-			override:    false,
+			Tag:         "cidr",
+			Translation: "Поле {0} должно содержать CIDR обозначения",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
 		},
 		{
-			tag:         "Status",
-			translation: "Статус",
-			override:    false,
+			Tag:         "cidrv4",
+			Translation: "Поле {0} должно содержать CIDR обозначения для IPv4 адреса",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
 		},
 		{
-			tag:         "Link",
-			translation: "Ссылка",
-			override:    false,
+			Tag:         "cidrv6",
+			Translation: "Поле {0} должно содержать CIDR обозначения для IPv6 адреса",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
 		},
 		{
-			tag:         "ScheduleID",
-			translation: "ID расписания",
-			override:    false,
+			Tag:         "tcp_addr",
+			Translation: "Поле {0} должно быть TCP адресом",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
 		},
 		{
-			tag:         "City",
-			translation: "Город",
-			override:    false,
+			Tag:         "tcp4_addr",
+			Translation: "Поле {0} должно быть IPv4 TCP адресом",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
 		},
 		{
-			tag:         "Rating",
-			translation: "Оценка",
-			override:    false,
+			Tag:         "tcp6_addr",
+			Translation: "Поле {0} должно быть IPv6 TCP адресом",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
 		},
 		{
-			tag:         "Type",
-			translation: "Тип",
-			override:    false,
+			Tag:         "udp_addr",
+			Translation: "Поле {0} должно быть UDP адресом",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
 		},
 		{
-			tag:         "Login",
-			translation: "Логин",
-			override:    false,
+			Tag:         "udp4_addr",
+			Translation: "Поле {0} должно быть IPv4 UDP адресом",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
 		},
 		{
-			tag:         "Password",
-			translation: "Пароль",
-			override:    false,
+			Tag:         "udp6_addr",
+			Translation: "Поле {0} должно быть IPv6 UDP адресом",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
 		},
 		{
-			tag:         "ParticipantsEmails",
-			translation: "Спикеры",
-			override:    false,
+			Tag:         "ip_addr",
+			Translation: "Поле {0} должно быть распознаваемым IP адресом",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
 		},
-	}
-
-	for _, t := range translations {
-
-		if t.customTransFunc != nil && t.customRegisFunc != nil {
-
-			err = v.RegisterTranslation(t.tag, trans, t.customRegisFunc, t.customTransFunc)
-
-		} else if t.customTransFunc != nil && t.customRegisFunc == nil {
-
-			err = v.RegisterTranslation(t.tag, trans, registrationFunc(t.tag, t.translation, t.override), t.customTransFunc)
+		{
+			Tag:         "ip4_addr",
+			Translation: "Поле {0} должно быть распознаваемым IPv4 адресом",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
+		},
+		{
+			Tag:         "ip6_addr",
+			Translation: "Поле {0} должно быть распознаваемым IPv6 адресом",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
+		},
+		{
+			Tag:         "unix_addr",
+			Translation: "Поле {0} должно быть распознаваемым UNIX адресом",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
+		},
+		{
+			Tag:         "mac",
+			Translation: "Поле {0} должно содержать MAC адрес",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
+		},
+		{
+			Tag:         "hostname",
+			Translation: "Поле {0} должно быть именем хоста (RFC 952)",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
+		},
+		{
+			Tag:         "hostname_rfc1123",
+			Translation: "Поле {0} должно быть именем хоста (RFC 1123)",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
+		},
+		{
+			Tag:         "fqdn",
+			Translation: "Поле {0} должно быть полным доменным именем (FQDN)",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
+		},
+		{
+			Tag:         "hostname_port",
+			Translation: "Поле {0} должно быть в формате хост:порт",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
+		},
+		{
+			Tag:         "port",
+			Translation: "Поле {0} должно быть номером порта",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
+		},
+		{
+			Tag:         "dns_rfc1035_label",
+			Translation: "Поле {0} должно быть DNS-меткой (RFC 1035)",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
+		},
+		{
+			Tag:         "http_url",
+			Translation: "Поле {0} должно быть HTTP или HTTPS URL",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
+		},
+		{
+			Tag:         "https_url",
+			Translation: "Поле {0} должно быть HTTPS URL",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
+		},
+		{
+			Tag:         "url_encoded",
+			Translation: "Поле {0} должно быть строкой, закодированной для URL",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
+		},
+		{
+			Tag:         "urn_rfc2141",
+			Translation: "Поле {0} должно быть URN (RFC 2141)",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
+		},
+		{
+			Tag:         "uds_exists",
+			Translation: "Поле {0} должно быть путём к существующему UNIX-сокету",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
+		},
+		{
+			Tag:         "file",
+			Translation: "Файл, указанный в поле {0}, не существует",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
+		},
+		{
+			Tag:         "filepath",
+			Translation: "Поле {0} содержит некорректный путь к файлу",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
+		},
+		{
+			Tag:         "image",
+			Translation: "Файл, указанный в поле {0}, не существует или не является изображением",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
+		},
+		{
+			Tag:         "dir",
+			Translation: "Каталог, указанный в поле {0}, не существует",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
+		},
+		{
+			Tag:         "dirpath",
+			Translation: "Поле {0} содержит некорректный путь к каталогу",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
+		},
+		{
+			Tag:         "unique",
+			Translation: "Поле {0} должно содержать уникальные значения",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
+		},
+		{
+			Tag:         "iscolor",
+			Translation: "Поле {0} должно быть цветом",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
+		},
+		{
+			Tag:         "oneof",
+			Translation: "Поле {0} должно быть одним из [{1}]",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
 
-		} else if t.customTransFunc == nil && t.customRegisFunc != nil {
+				fld := o.fieldName(fe)
 
-			err = v.RegisterTranslation(t.tag, trans, t.customRegisFunc, translateFunc)
+				s, err := ut.T(fe.Tag(), fld, fe.Param())
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return s
+			},
+		},
+		{
+			Tag:         "oneofci",
+			Translation: "Поле {0} должно быть одним из [{1}] без учёта регистра",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
 
-		} else {
-			err = v.RegisterTranslation(t.tag, trans, registrationFunc(t.tag, t.translation, t.override), translateFunc)
-		}
+				fld := o.fieldName(fe)
 
-		if err != nil {
-			return
-		}
+				s, err := ut.T(fe.Tag(), fld, fe.Param())
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return s
+			},
+		},
+		{
+			Tag:         "isdefault",
+			Translation: "Поле {0} должно иметь значение по умолчанию",
+			Override:    false,
+			CustomTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				fld := o.fieldName(fe)
+				t, err := ut.T(fe.Tag(), fld)
+				if err != nil {
+					return o.fallback(fe, err)
+				}
+				return t
+			},
+		},
 	}
 
-	return
+	return registerCatalog(v, trans, translations, o)
 }
 
-func registrationFunc(tag string, translation string, override bool) validator.RegisterTranslationsFunc {
+func (o *options) registrationFunc(tag string, translation string, override bool) validator.RegisterTranslationsFunc {
 
 	return func(ut ut.Translator) (err error) {
 
-		if err = ut.Add(tag, translation, override); err != nil {
+		if err = o.add(ut, tag, translation, override); err != nil {
 			return
 		}
 
@@ -2334,12 +3161,11 @@ func registrationFunc(tag string, translation string, override bool) validator.R
 
 }
 
-func translateFunc(ut ut.Translator, fe validator.FieldError) string {
+func (o *options) translateFunc(ut ut.Translator, fe validator.FieldError) string {
 
-	t, err := ut.T(fe.Tag(), fe.Field())
+	t, err := o.t(ut, fe.Tag(), o.label(fe), Label{Name: fe.Param()})
 	if err != nil {
-		log.Printf("warning: error translating FieldError: %#v", fe)
-		return fe.(error).Error()
+		return o.fallback(fe, err)
 	}
 
 	return t
@@ -0,0 +1,1495 @@
+package ru
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+)
+
+type inner struct {
+	Value string
+}
+
+func TestTranslations(t *testing.T) {
+
+	defer func(orig func() time.Time) { now = orig }(now)
+	now = func() time.Time { return time.Date(2026, time.October, 17, 15, 0, 0, 0, time.UTC) }
+
+	validate := validator.New()
+	trans := NewTranslator()
+
+	if err := RegisterDefaultTranslations(validate, trans); err != nil {
+		t.Fatalf("RegisterDefaultTranslations: %v", err)
+	}
+
+	// validator compares time.Time fields with the wall clock, not with now
+	wallClock := time.Now()
+
+	tests := []struct {
+		tag      string
+		value    interface{}
+		expected string
+	}{
+		{
+			tag: "required",
+			value: struct {
+				Name string `validate:"required"`
+			}{},
+			expected: "Name обязательное поле",
+		},
+		{
+			tag: "len",
+			value: struct {
+				Code string `validate:"len=1"`
+			}{Code: "ab"},
+			expected: "Поле Code должно быть длиной в 1 символ",
+		},
+		{
+			tag: "len",
+			value: struct {
+				Code string `validate:"len=3"`
+			}{Code: "ab"},
+			expected: "Поле Code должно быть длиной в 3 символа",
+		},
+		{
+			tag: "len",
+			value: struct {
+				Code string `validate:"len=5"`
+			}{Code: "ab"},
+			expected: "Поле Code должно быть длиной в 5 символов",
+		},
+		{
+			tag: "len",
+			value: struct {
+				Amount float64 `validate:"len=1113.00"`
+			}{},
+			expected: "Поле Amount должно быть равно 1\u202f113",
+		},
+		{
+			tag: "len",
+			value: struct {
+				Items []string `validate:"len=2"`
+			}{},
+			expected: "Поле Items должно содержать 2 элемента",
+		},
+		{
+			tag: "len",
+			value: struct {
+				Items []string `validate:"len=7"`
+			}{},
+			expected: "Поле Items должно содержать 7 элементов",
+		},
+		{
+			tag: "min",
+			value: struct {
+				Name string `validate:"min=1"`
+			}{},
+			expected: "Поле Name должно содержать минимум 1 символ",
+		},
+		{
+			tag: "min",
+			value: struct {
+				Name string `validate:"min=2"`
+			}{},
+			expected: "Поле Name должно содержать минимум 2 символа",
+		},
+		{
+			tag: "min",
+			value: struct {
+				Amount float64 `validate:"min=1113.00"`
+			}{},
+			expected: "Поле Amount должно быть больше или равно 1\u202f113",
+		},
+		{
+			tag: "min",
+			value: struct {
+				Items []string `validate:"min=7"`
+			}{},
+			expected: "Поле Items должно содержать минимум 7 элементов",
+		},
+		{
+			tag: "max",
+			value: struct {
+				Name string `validate:"max=3"`
+			}{Name: "abcd"},
+			expected: "Поле Name должно содержать максимум 3 символа",
+		},
+		{
+			tag: "max",
+			value: struct {
+				Amount float64 `validate:"max=1113.00"`
+			}{Amount: 2000},
+			expected: "Поле Amount должно быть меньше или равно 1\u202f113",
+		},
+		{
+			tag: "max",
+			value: struct {
+				Items []string `validate:"max=1"`
+			}{Items: []string{"a", "b"}},
+			expected: "Поле Items должно содержать максимум 1 элемент",
+		},
+		{
+			tag: "eq",
+			value: struct {
+				Name string `validate:"eq=3"`
+			}{},
+			expected: "Поле Name должно быть равно 3",
+		},
+		{
+			tag: "eq_ignore_case",
+			value: struct {
+				City string `validate:"eq_ignore_case=Москва"`
+			}{City: "Питер"},
+			expected: "Поле City должно быть равно Москва без учёта регистра",
+		},
+		{
+			tag: "ne",
+			value: struct {
+				Amount float64 `validate:"ne=0.00"`
+			}{},
+			expected: "Поле Amount должно быть не равно 0.00",
+		},
+		{
+			tag: "ne_ignore_case",
+			value: struct {
+				City string `validate:"ne_ignore_case=Москва"`
+			}{City: "МОСКВА"},
+			expected: "Поле City должно быть не равно Москва без учёта регистра",
+		},
+		{
+			tag: "lt",
+			value: struct {
+				Name string `validate:"lt=3"`
+			}{Name: "abcd"},
+			expected: "Поле Name должно иметь менее 3 символов",
+		},
+		{
+			tag: "lt",
+			value: struct {
+				Amount float64 `validate:"lt=5.56"`
+			}{Amount: 6},
+			expected: "Поле Amount должно быть менее 5,56",
+		},
+		{
+			tag: "lt",
+			value: struct {
+				Items []string `validate:"lt=2"`
+			}{Items: []string{"a", "b"}},
+			expected: "Поле Items должно содержать менее 2 элементов",
+		},
+		{
+			tag: "lt",
+			value: struct {
+				At time.Time `validate:"lt"`
+			}{At: wallClock.Add(time.Hour)},
+			expected: "Поле At должно быть ранее 17.10.2026 18:00 МСК",
+		},
+		{
+			tag: "lte",
+			value: struct {
+				Name string `validate:"lte=3"`
+			}{Name: "abcd"},
+			expected: "Поле Name должно содержать максимум 3 символа",
+		},
+		{
+			tag: "lte",
+			value: struct {
+				Amount float64 `validate:"lte=5.56"`
+			}{Amount: 6},
+			expected: "Поле Amount должно быть менее или равно 5,56",
+		},
+		{
+			tag: "lte",
+			value: struct {
+				Items []string `validate:"lte=1"`
+			}{Items: []string{"a", "b"}},
+			expected: "Поле Items должно содержать максимум 1 элемент",
+		},
+		{
+			tag: "lte",
+			value: struct {
+				At time.Time `validate:"lte"`
+			}{At: wallClock.Add(time.Hour)},
+			expected: "Поле At должно быть не позднее 17.10.2026 18:00 МСК",
+		},
+		{
+			tag: "lte",
+			value: struct {
+				At time.Time `validate:"lte=tomorrow"`
+			}{At: wallClock.Add(time.Hour)},
+			expected: "Поле At должно быть не позднее 17.10.2026 18:00 МСК",
+		},
+		{
+			tag: "gt",
+			value: struct {
+				Name string `validate:"gt=3"`
+			}{},
+			expected: "Поле Name должно быть длиннее 3 символов",
+		},
+		{
+			tag: "gt",
+			value: struct {
+				Amount float64 `validate:"gt=5.56"`
+			}{},
+			expected: "Поле Amount должно быть больше 5,56",
+		},
+		{
+			tag: "gt",
+			value: struct {
+				Items []string `validate:"gt=7"`
+			}{},
+			expected: "Поле Items должно содержать более 7 элементов",
+		},
+		{
+			tag: "gt",
+			value: struct {
+				At time.Time `validate:"gt"`
+			}{At: wallClock.Add(-time.Hour)},
+			expected: "Поле At должно быть позднее 17.10.2026 18:00 МСК",
+		},
+		{
+			tag: "gte",
+			value: struct {
+				Name string `validate:"gte=5"`
+			}{},
+			expected: "Поле Name должно содержать минимум 5 символов",
+		},
+		{
+			tag: "gte",
+			value: struct {
+				Amount float64 `validate:"gte=5.56"`
+			}{},
+			expected: "Поле Amount должно быть больше или равно 5,56",
+		},
+		{
+			tag: "gte",
+			value: struct {
+				Items []string `validate:"gte=2"`
+			}{},
+			expected: "Поле Items должно содержать минимум 2 элемента",
+		},
+		{
+			tag: "gte",
+			value: struct {
+				At time.Time `validate:"gte"`
+			}{At: wallClock.Add(-time.Hour)},
+			expected: "Поле At должно быть не ранее 17.10.2026 18:00 МСК",
+		},
+		{
+			tag: "len",
+			value: struct {
+				Timeout time.Duration `validate:"len=1h30m"`
+			}{Timeout: time.Hour},
+			expected: "Поле Timeout должно составлять 1 час 30 минут",
+		},
+		{
+			tag: "min",
+			value: struct {
+				Timeout time.Duration `validate:"min=1h30m"`
+			}{Timeout: time.Hour},
+			expected: "Поле Timeout должно быть не менее 1 часа 30 минут",
+		},
+		{
+			tag: "max",
+			value: struct {
+				Timeout time.Duration `validate:"max=2m"`
+			}{Timeout: time.Hour},
+			expected: "Поле Timeout должно быть не более 2 минут",
+		},
+		{
+			tag: "lt",
+			value: struct {
+				Timeout time.Duration `validate:"lt=21s"`
+			}{Timeout: time.Hour},
+			expected: "Поле Timeout должно быть менее 21 секунды",
+		},
+		{
+			tag: "lte",
+			value: struct {
+				Timeout time.Duration `validate:"lte=1500ms"`
+			}{Timeout: time.Hour},
+			expected: "Поле Timeout должно быть не более 1 секунды 500 миллисекунд",
+		},
+		{
+			tag: "gt",
+			value: struct {
+				Timeout time.Duration `validate:"gt=24h"`
+			}{Timeout: time.Hour},
+			expected: "Поле Timeout должно быть более 24 часов",
+		},
+		{
+			tag: "gte",
+			value: struct {
+				Timeout time.Duration `validate:"gte=1000000000"`
+			}{Timeout: time.Second / 2},
+			expected: "Поле Timeout должно быть не менее 1 секунды",
+		},
+		{
+			tag: "eqfield",
+			value: struct {
+				Secret  string `validate:"eqfield=Confirm"`
+				Confirm string
+			}{Secret: "a", Confirm: "b"},
+			expected: "Поле Secret должно быть равно Confirm",
+		},
+		{
+			tag: "nefield",
+			value: struct {
+				Secret string `validate:"nefield=Other"`
+				Other  string
+			}{},
+			expected: "Поле Secret не должно быть равно Other",
+		},
+		{
+			tag: "gtfield",
+			value: struct {
+				Max int `validate:"gtfield=Min"`
+				Min int
+			}{},
+			expected: "Поле Max должно быть больше Min",
+		},
+		{
+			tag: "gtefield",
+			value: struct {
+				Max int `validate:"gtefield=Min"`
+				Min int
+			}{Min: 1},
+			expected: "Поле Max должно быть больше или равно Min",
+		},
+		{
+			tag: "ltfield",
+			value: struct {
+				Min int `validate:"ltfield=Max"`
+				Max int
+			}{},
+			expected: "Поле Min должно быть менее Max",
+		},
+		{
+			tag: "ltefield",
+			value: struct {
+				Min int `validate:"ltefield=Max"`
+				Max int
+			}{Min: 1},
+			expected: "Поле Min должно быть менее или равно Max",
+		},
+		{
+			tag: "eqcsfield",
+			value: struct {
+				Inner inner
+				Value string `validate:"eqcsfield=Inner.Value"`
+			}{Inner: inner{Value: "a"}},
+			expected: "Поле Value должно быть равно Inner.Value",
+		},
+		{
+			tag: "necsfield",
+			value: struct {
+				Inner inner
+				Value string `validate:"necsfield=Inner.Value"`
+			}{},
+			expected: "Поле Value не должно быть равно Inner.Value",
+		},
+		{
+			tag: "gtcsfield",
+			value: struct {
+				Inner inner
+				Value string `validate:"gtcsfield=Inner.Value"`
+			}{Inner: inner{Value: "b"}, Value: "a"},
+			expected: "Поле Value должно быть больше Inner.Value",
+		},
+		{
+			tag: "gtecsfield",
+			value: struct {
+				Inner inner
+				Value string `validate:"gtecsfield=Inner.Value"`
+			}{Inner: inner{Value: "b"}, Value: "a"},
+			expected: "Поле Value должно быть больше или равно Inner.Value",
+		},
+		{
+			tag: "ltcsfield",
+			value: struct {
+				Inner inner
+				Value string `validate:"ltcsfield=Inner.Value"`
+			}{Inner: inner{Value: "a"}, Value: "b"},
+			expected: "Поле Value должно быть менее Inner.Value",
+		},
+		{
+			tag: "ltecsfield",
+			value: struct {
+				Inner inner
+				Value string `validate:"ltecsfield=Inner.Value"`
+			}{Inner: inner{Value: "a"}, Value: "b"},
+			expected: "Поле Value должно быть менее или равно Inner.Value",
+		},
+		{
+			tag: "alpha",
+			value: struct {
+				Value string `validate:"alpha"`
+			}{Value: "1"},
+			expected: "Поле Value должно содержать только буквы",
+		},
+		{
+			tag: "alphanum",
+			value: struct {
+				Value string `validate:"alphanum"`
+			}{Value: "-"},
+			expected: "Поле Value должно содержать только буквы и цифры",
+		},
+		{
+			tag: "numeric",
+			value: struct {
+				Value string `validate:"numeric"`
+			}{Value: "a"},
+			expected: "Поле Value должно быть цифровым значением",
+		},
+		{
+			tag: "boolean",
+			value: struct {
+				Value string `validate:"boolean"`
+			}{Value: "yes"},
+			expected: "Поле Value должно быть логическим значением (true или false)",
+		},
+		{
+			tag: "number",
+			value: struct {
+				Value string `validate:"number"`
+			}{Value: "a"},
+			expected: "Поле Value должно быть цифрой",
+		},
+		{
+			tag: "hexadecimal",
+			value: struct {
+				Value string `validate:"hexadecimal"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть шестнадцатеричной строкой",
+		},
+		{
+			tag: "hexcolor",
+			value: struct {
+				Value string `validate:"hexcolor"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть HEX цветом",
+		},
+		{
+			tag: "rgb",
+			value: struct {
+				Value string `validate:"rgb"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть RGB цветом",
+		},
+		{
+			tag: "rgba",
+			value: struct {
+				Value string `validate:"rgba"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть RGBA цветом",
+		},
+		{
+			tag: "hsl",
+			value: struct {
+				Value string `validate:"hsl"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть HSL цветом",
+		},
+		{
+			tag: "hsla",
+			value: struct {
+				Value string `validate:"hsla"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть HSLA цветом",
+		},
+		{
+			tag: "e164",
+			value: struct {
+				Value string `validate:"e164"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть номером телефона в формате E.164",
+		},
+		{
+			tag: "email",
+			value: struct {
+				Value string `validate:"email"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть email адресом",
+		},
+		{
+			tag: "url",
+			value: struct {
+				Value string `validate:"url"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть URL",
+		},
+		{
+			tag: "uri",
+			value: struct {
+				Value string `validate:"uri"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть URI",
+		},
+		{
+			tag: "base64",
+			value: struct {
+				Value string `validate:"base64"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть Base64 строкой",
+		},
+		{
+			tag: "base32",
+			value: struct {
+				Value string `validate:"base32"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть Base32 строкой",
+		},
+		{
+			tag: "base64url",
+			value: struct {
+				Value string `validate:"base64url"`
+			}{Value: "a+b/"},
+			expected: "Поле Value должно быть Base64URL строкой",
+		},
+		{
+			tag: "base64rawurl",
+			value: struct {
+				Value string `validate:"base64rawurl"`
+			}{Value: "a+b/"},
+			expected: "Поле Value должно быть Base64URL строкой без дополнения",
+		},
+		{
+			tag: "md4",
+			value: struct {
+				Value string `validate:"md4"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть хешем MD4",
+		},
+		{
+			tag: "md5",
+			value: struct {
+				Value string `validate:"md5"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть хешем MD5",
+		},
+		{
+			tag: "sha256",
+			value: struct {
+				Signature string `validate:"sha256"`
+			}{Signature: "z"},
+			expected: "Поле Signature должно быть хешем SHA-256",
+		},
+		{
+			tag: "sha384",
+			value: struct {
+				Value string `validate:"sha384"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть хешем SHA-384",
+		},
+		{
+			tag: "sha512",
+			value: struct {
+				Value string `validate:"sha512"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть хешем SHA-512",
+		},
+		{
+			tag: "ripemd128",
+			value: struct {
+				Value string `validate:"ripemd128"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть хешем RIPEMD-128",
+		},
+		{
+			tag: "ripemd160",
+			value: struct {
+				Value string `validate:"ripemd160"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть хешем RIPEMD-160",
+		},
+		{
+			tag: "tiger128",
+			value: struct {
+				Value string `validate:"tiger128"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть хешем Tiger-128",
+		},
+		{
+			tag: "tiger160",
+			value: struct {
+				Value string `validate:"tiger160"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть хешем Tiger-160",
+		},
+		{
+			tag: "tiger192",
+			value: struct {
+				Value string `validate:"tiger192"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть хешем Tiger-192",
+		},
+		{
+			tag: "html",
+			value: struct {
+				Value string `validate:"html"`
+			}{Value: "text"},
+			expected: "Поле Value должно содержать HTML-разметку",
+		},
+		{
+			tag: "html_encoded",
+			value: struct {
+				Value string `validate:"html_encoded"`
+			}{Value: "text"},
+			expected: "Поле Value должно быть закодировано для HTML",
+		},
+		{
+			tag: "json",
+			value: struct {
+				Value string `validate:"json"`
+			}{Value: "{"},
+			expected: "Поле Value должно быть корректным JSON",
+		},
+		{
+			tag: "jwt",
+			value: struct {
+				Token string `validate:"jwt"`
+			}{Token: "token"},
+			expected: "Поле Token должно быть JWT-токеном",
+		},
+		{
+			tag: "contains",
+			value: struct {
+				Value string `validate:"contains=purpose"`
+			}{Value: "z"},
+			expected: "Поле Value должно содержать текст 'purpose'",
+		},
+		{
+			tag: "containsany",
+			value: struct {
+				Value string `validate:"containsany=!@#$"`
+			}{Value: "z"},
+			expected: "Поле Value должно содержать минимум один из символов '!@#$'",
+		},
+		{
+			tag: "excludes",
+			value: struct {
+				Value string `validate:"excludes=text"`
+			}{Value: "text"},
+			expected: "Поле Value не должно содержать текст 'text'",
+		},
+		{
+			tag: "excludesall",
+			value: struct {
+				Value string `validate:"excludesall=!@#$"`
+			}{Value: "!"},
+			expected: "Поле Value не должно содержать символы '!@#$'",
+		},
+		{
+			tag: "excludesrune",
+			value: struct {
+				Value string `validate:"excludesrune=☻"`
+			}{Value: "☻"},
+			expected: "Поле Value не должно содержать '☻'",
+		},
+		{
+			tag: "fieldcontains",
+			value: struct {
+				Login    string
+				Password string `validate:"fieldcontains=Login"`
+			}{Login: "admin", Password: "secret"},
+			expected: "Поле Password должно содержать значение поля Login",
+		},
+		{
+			tag: "fieldexcludes",
+			value: struct {
+				Login    string
+				Password string `validate:"fieldexcludes=Login"`
+			}{Login: "admin", Password: "admin123"},
+			expected: "Поле Password не должно содержать значение поля Login",
+		},
+		{
+			tag: "startswith",
+			value: struct {
+				Value string `validate:"startswith=ab"`
+			}{Value: "xyz"},
+			expected: "Поле Value должно начинаться с «ab»",
+		},
+		{
+			tag: "endswith",
+			value: struct {
+				Value string `validate:"endswith=yz"`
+			}{Value: "abc"},
+			expected: "Поле Value должно заканчиваться на «yz»",
+		},
+		{
+			tag: "startsnotwith",
+			value: struct {
+				Value string `validate:"startsnotwith=ab"`
+			}{Value: "abc"},
+			expected: "Поле Value не должно начинаться с «ab»",
+		},
+		{
+			tag: "endsnotwith",
+			value: struct {
+				Value string `validate:"endsnotwith=bc"`
+			}{Value: "abc"},
+			expected: "Поле Value не должно заканчиваться на «bc»",
+		},
+		{
+			tag: "lowercase",
+			value: struct {
+				Login string `validate:"lowercase"`
+			}{Login: "ABC"},
+			expected: "Поле Login должно быть в нижнем регистре",
+		},
+		{
+			tag: "uppercase",
+			value: struct {
+				Value string `validate:"uppercase"`
+			}{Value: "abc"},
+			expected: "Поле Value должно быть в верхнем регистре",
+		},
+		{
+			tag: "isbn",
+			value: struct {
+				Value string `validate:"isbn"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть ISBN номером",
+		},
+		{
+			tag: "isbn10",
+			value: struct {
+				Value string `validate:"isbn10"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть ISBN-10 номером",
+		},
+		{
+			tag: "isbn13",
+			value: struct {
+				Value string `validate:"isbn13"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть ISBN-13 номером",
+		},
+		{
+			tag: "uuid",
+			value: struct {
+				Value string `validate:"uuid"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть UUID",
+		},
+		{
+			tag: "uuid3",
+			value: struct {
+				Value string `validate:"uuid3"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть UUID 3 версии",
+		},
+		{
+			tag: "uuid4",
+			value: struct {
+				Value string `validate:"uuid4"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть UUID 4 версии",
+		},
+		{
+			tag: "uuid5",
+			value: struct {
+				Value string `validate:"uuid5"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть UUID 5 версии",
+		},
+		{
+			tag: "uuid_rfc4122",
+			value: struct {
+				Value string `validate:"uuid_rfc4122"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть UUID в формате RFC 4122",
+		},
+		{
+			tag: "uuid3_rfc4122",
+			value: struct {
+				Value string `validate:"uuid3_rfc4122"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть UUID 3 версии в формате RFC 4122",
+		},
+		{
+			tag: "uuid4_rfc4122",
+			value: struct {
+				Value string `validate:"uuid4_rfc4122"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть UUID 4 версии в формате RFC 4122",
+		},
+		{
+			tag: "uuid5_rfc4122",
+			value: struct {
+				Value string `validate:"uuid5_rfc4122"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть UUID 5 версии в формате RFC 4122",
+		},
+		{
+			tag: "ulid",
+			value: struct {
+				Value string `validate:"ulid"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть ULID",
+		},
+		{
+			tag: "mongodb",
+			value: struct {
+				Value string `validate:"mongodb"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть идентификатором ObjectId MongoDB",
+		},
+		{
+			tag: "mongodb_connection_string",
+			value: struct {
+				Value string `validate:"mongodb_connection_string"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть строкой подключения к MongoDB",
+		},
+		{
+			tag: "cve",
+			value: struct {
+				Value string `validate:"cve"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть идентификатором CVE",
+		},
+		{
+			tag: "semver",
+			value: struct {
+				Value string `validate:"semver"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть версией в формате SemVer",
+		},
+		{
+			tag: "cron",
+			value: struct {
+				Value string `validate:"cron"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть cron-выражением",
+		},
+		{
+			tag: "spicedb",
+			value: struct {
+				Value string `validate:"spicedb"`
+			}{Value: "a b"},
+			expected: "Поле Value должно быть идентификатором объекта SpiceDB",
+		},
+		{
+			tag: "spicedb",
+			value: struct {
+				Value string `validate:"spicedb=permission"`
+			}{Value: "A"},
+			expected: "Поле Value должно быть названием разрешения SpiceDB",
+		},
+		{
+			tag: "spicedb",
+			value: struct {
+				Value string `validate:"spicedb=type"`
+			}{Value: "A"},
+			expected: "Поле Value должно быть типом объекта SpiceDB",
+		},
+		{
+			tag: "ascii",
+			value: struct {
+				Value string `validate:"ascii"`
+			}{Value: "ф"},
+			expected: "Поле Value должно содержать только ascii символы",
+		},
+		{
+			tag: "printascii",
+			value: struct {
+				Value string `validate:"printascii"`
+			}{Value: "ф"},
+			expected: "Поле Value должно содержать только доступные для печати ascii символы",
+		},
+		{
+			tag: "multibyte",
+			value: struct {
+				Value string `validate:"multibyte"`
+			}{Value: "z"},
+			expected: "Поле Value должно содержать мультибайтные символы",
+		},
+		{
+			tag: "datauri",
+			value: struct {
+				Value string `validate:"datauri"`
+			}{Value: "z"},
+			expected: "Поле Value должно содержать Data URI",
+		},
+		{
+			tag: "latitude",
+			value: struct {
+				Value string `validate:"latitude"`
+			}{Value: "z"},
+			expected: "Поле Value должно содержать координаты широты",
+		},
+		{
+			tag: "longitude",
+			value: struct {
+				Value string `validate:"longitude"`
+			}{Value: "z"},
+			expected: "Поле Value должно содержать координаты долготы",
+		},
+		{
+			tag: "iso3166_1_alpha2",
+			value: struct {
+				Value string `validate:"iso3166_1_alpha2"`
+			}{Value: "zz"},
+			expected: "Поле Value должно быть двухбуквенным кодом страны ISO 3166-1 (например, RU)",
+		},
+		{
+			tag: "iso3166_1_alpha2_eu",
+			value: struct {
+				Value string `validate:"iso3166_1_alpha2_eu"`
+			}{Value: "zz"},
+			expected: "Поле Value должно быть двухбуквенным кодом страны Евросоюза ISO 3166-1 (например, DE)",
+		},
+		{
+			tag: "iso3166_1_alpha3",
+			value: struct {
+				Value string `validate:"iso3166_1_alpha3"`
+			}{Value: "zz"},
+			expected: "Поле Value должно быть трёхбуквенным кодом страны ISO 3166-1 (например, RUS)",
+		},
+		{
+			tag: "iso3166_1_alpha3_eu",
+			value: struct {
+				Value string `validate:"iso3166_1_alpha3_eu"`
+			}{Value: "zz"},
+			expected: "Поле Value должно быть трёхбуквенным кодом страны Евросоюза ISO 3166-1 (например, DEU)",
+		},
+		{
+			tag: "iso3166_1_alpha_numeric",
+			value: struct {
+				Value int `validate:"iso3166_1_alpha_numeric"`
+			}{Value: 1},
+			expected: "Поле Value должно быть цифровым кодом страны ISO 3166-1 (например, 643)",
+		},
+		{
+			tag: "iso3166_1_alpha_numeric_eu",
+			value: struct {
+				Value int `validate:"iso3166_1_alpha_numeric_eu"`
+			}{Value: 1},
+			expected: "Поле Value должно быть цифровым кодом страны Евросоюза ISO 3166-1 (например, 276)",
+		},
+		{
+			tag: "iso3166_2",
+			value: struct {
+				Value string `validate:"iso3166_2"`
+			}{Value: "zz"},
+			expected: "Поле Value должно быть кодом региона ISO 3166-2 (например, RU-MOW)",
+		},
+		{
+			tag: "bcp47_language_tag",
+			value: struct {
+				Value string `validate:"bcp47_language_tag"`
+			}{Value: "zz"},
+			expected: "Поле Value должно быть тегом языка BCP 47 (например, ru-RU)",
+		},
+		{
+			tag: "timezone",
+			value: struct {
+				Value string `validate:"timezone"`
+			}{Value: "zz"},
+			expected: "Поле Value должно быть часовым поясом из базы IANA (например, Europe/Moscow)",
+		},
+		{
+			tag: "datetime",
+			value: struct {
+				Value string `validate:"datetime=02.01.2006"`
+			}{Value: "2026-10-17"},
+			expected: "Поле Value должно быть в формате ДД.ММ.ГГГГ, например 17.10.2026",
+		},
+		{
+			tag: "datetime",
+			value: struct {
+				Value string `validate:"datetime=2006-01-02 15:04"`
+			}{Value: "17.10.2026"},
+			expected: "Поле Value должно быть в формате ГГГГ-ММ-ДД чч:мм, например 2026-10-17 15:00",
+		},
+		{
+			tag: "datetime",
+			value: struct {
+				Value string `validate:"datetime=02 January 2006"`
+			}{Value: "17 октября 2026"},
+			expected: "Поле Value должно быть в формате ДД ММММ (January, February…) ГГГГ",
+		},
+		{
+			tag: "postcode_iso3166_alpha2",
+			value: struct {
+				Value string `validate:"postcode_iso3166_alpha2=RU"`
+			}{Value: "abc"},
+			expected: "Поле Value не является почтовым индексом для страны Россия",
+		},
+		{
+			tag: "postcode_iso3166_alpha2",
+			value: struct {
+				Value string `validate:"postcode_iso3166_alpha2=QQ"`
+			}{Value: "abc"},
+			expected: "Поле Value не является почтовым индексом для страны QQ",
+		},
+		{
+			tag: "postcode_iso3166_alpha2_field",
+			value: struct {
+				Country  string
+				Postcode string `validate:"postcode_iso3166_alpha2_field=Country"`
+			}{Country: "RU", Postcode: "abc"},
+			expected: "Поле Postcode не является почтовым индексом для страны, указанной в поле Country",
+		},
+		{
+			tag: "ssn",
+			value: struct {
+				Value string `validate:"ssn"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть SSN номером",
+		},
+		{
+			tag: "credit_card",
+			value: struct {
+				Value string `validate:"credit_card"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть номером банковской карты",
+		},
+		{
+			tag: "luhn_checksum",
+			value: struct {
+				Value string `validate:"luhn_checksum"`
+			}{Value: "z"},
+			expected: "Поле Value должно иметь корректную контрольную сумму по алгоритму Луна",
+		},
+		{
+			tag: "iso4217",
+			value: struct {
+				Currency string `validate:"iso4217"`
+			}{Currency: "XYZ"},
+			expected: "Поле Currency должно быть кодом валюты ISO 4217 (например, RUB)",
+		},
+		{
+			tag: "iso4217_numeric",
+			value: struct {
+				Currency int `validate:"iso4217_numeric"`
+			}{Currency: 1},
+			expected: "Поле Currency должно быть цифровым кодом валюты ISO 4217 (например, 643)",
+		},
+		{
+			tag: "bic",
+			value: struct {
+				Value string `validate:"bic"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть BIC (SWIFT) кодом банка",
+		},
+		{
+			tag: "bic_iso_9362_2014",
+			value: struct {
+				Value string `validate:"bic_iso_9362_2014"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть BIC (SWIFT) кодом банка по ISO 9362:2014",
+		},
+		{
+			tag: "btc_addr",
+			value: struct {
+				Value string `validate:"btc_addr"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть адресом Bitcoin",
+		},
+		{
+			tag: "btc_addr_bech32",
+			value: struct {
+				Value string `validate:"btc_addr_bech32"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть адресом Bitcoin в формате Bech32",
+		},
+		{
+			tag: "eth_addr",
+			value: struct {
+				Value string `validate:"eth_addr"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть адресом Ethereum",
+		},
+		{
+			tag: "eth_addr_checksum",
+			value: struct {
+				Value string `validate:"eth_addr_checksum"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть адресом Ethereum с контрольной суммой",
+		},
+		{
+			tag: "ipv4",
+			value: struct {
+				Value string `validate:"ipv4"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть IPv4 адресом",
+		},
+		{
+			tag: "ipv6",
+			value: struct {
+				Value string `validate:"ipv6"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть IPv6 адресом",
+		},
+		{
+			tag: "ip",
+			value: struct {
+				Value string `validate:"ip"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть IP адресом",
+		},
+		{
+			tag: "cidr",
+			value: struct {
+				Value string `validate:"cidr"`
+			}{Value: "z"},
+			expected: "Поле Value должно содержать CIDR обозначения",
+		},
+		{
+			tag: "cidrv4",
+			value: struct {
+				Value string `validate:"cidrv4"`
+			}{Value: "z"},
+			expected: "Поле Value должно содержать CIDR обозначения для IPv4 адреса",
+		},
+		{
+			tag: "cidrv6",
+			value: struct {
+				Value string `validate:"cidrv6"`
+			}{Value: "z"},
+			expected: "Поле Value должно содержать CIDR обозначения для IPv6 адреса",
+		},
+		{
+			tag: "tcp_addr",
+			value: struct {
+				Value string `validate:"tcp_addr"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть TCP адресом",
+		},
+		{
+			tag: "tcp4_addr",
+			value: struct {
+				Value string `validate:"tcp4_addr"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть IPv4 TCP адресом",
+		},
+		{
+			tag: "tcp6_addr",
+			value: struct {
+				Value string `validate:"tcp6_addr"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть IPv6 TCP адресом",
+		},
+		{
+			tag: "udp_addr",
+			value: struct {
+				Value string `validate:"udp_addr"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть UDP адресом",
+		},
+		{
+			tag: "udp4_addr",
+			value: struct {
+				Value string `validate:"udp4_addr"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть IPv4 UDP адресом",
+		},
+		{
+			tag: "udp6_addr",
+			value: struct {
+				Value string `validate:"udp6_addr"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть IPv6 UDP адресом",
+		},
+		{
+			tag: "ip_addr",
+			value: struct {
+				Value string `validate:"ip_addr"`
+			}{Value: "z:z"},
+			expected: "Поле Value должно быть распознаваемым IP адресом",
+		},
+		{
+			tag: "ip4_addr",
+			value: struct {
+				Value string `validate:"ip4_addr"`
+			}{Value: "z:z"},
+			expected: "Поле Value должно быть распознаваемым IPv4 адресом",
+		},
+		{
+			tag: "ip6_addr",
+			value: struct {
+				Value string `validate:"ip6_addr"`
+			}{Value: "z:z"},
+			expected: "Поле Value должно быть распознаваемым IPv6 адресом",
+		},
+		{
+			tag: "mac",
+			value: struct {
+				Value string `validate:"mac"`
+			}{Value: "z"},
+			expected: "Поле Value должно содержать MAC адрес",
+		},
+		{
+			tag: "hostname",
+			value: struct {
+				Value string `validate:"hostname"`
+			}{Value: "-host"},
+			expected: "Поле Value должно быть именем хоста (RFC 952)",
+		},
+		{
+			tag: "hostname_rfc1123",
+			value: struct {
+				Value string `validate:"hostname_rfc1123"`
+			}{Value: "host_name"},
+			expected: "Поле Value должно быть именем хоста (RFC 1123)",
+		},
+		{
+			tag: "fqdn",
+			value: struct {
+				Value string `validate:"fqdn"`
+			}{Value: "localhost"},
+			expected: "Поле Value должно быть полным доменным именем (FQDN)",
+		},
+		{
+			tag: "hostname_port",
+			value: struct {
+				Value string `validate:"hostname_port"`
+			}{Value: "localhost"},
+			expected: "Поле Value должно быть в формате хост:порт",
+		},
+		{
+			tag: "port",
+			value: struct {
+				Value uint `validate:"port"`
+			}{Value: 70000},
+			expected: "Поле Value должно быть номером порта",
+		},
+		{
+			tag: "dns_rfc1035_label",
+			value: struct {
+				Value string `validate:"dns_rfc1035_label"`
+			}{Value: "1abc"},
+			expected: "Поле Value должно быть DNS-меткой (RFC 1035)",
+		},
+		{
+			tag: "http_url",
+			value: struct {
+				Value string `validate:"http_url"`
+			}{Value: "ftp://example.com"},
+			expected: "Поле Value должно быть HTTP или HTTPS URL",
+		},
+		{
+			tag: "https_url",
+			value: struct {
+				Value string `validate:"https_url"`
+			}{Value: "http://example.com"},
+			expected: "Поле Value должно быть HTTPS URL",
+		},
+		{
+			tag: "url_encoded",
+			value: struct {
+				Value string `validate:"url_encoded"`
+			}{Value: "%zz"},
+			expected: "Поле Value должно быть строкой, закодированной для URL",
+		},
+		{
+			tag: "urn_rfc2141",
+			value: struct {
+				Value string `validate:"urn_rfc2141"`
+			}{Value: "urn"},
+			expected: "Поле Value должно быть URN (RFC 2141)",
+		},
+		{
+			tag: "uds_exists",
+			value: struct {
+				Value string `validate:"uds_exists"`
+			}{Value: "/nonexistent.sock"},
+			expected: "Поле Value должно быть путём к существующему UNIX-сокету",
+		},
+		{
+			tag: "file",
+			value: struct {
+				FileName string `validate:"file"`
+			}{FileName: "/nonexistent/file.txt"},
+			expected: "Файл, указанный в поле FileName, не существует",
+		},
+		{
+			tag: "filepath",
+			value: struct {
+				FileName string `validate:"filepath"`
+			}{FileName: "/"},
+			expected: "Поле FileName содержит некорректный путь к файлу",
+		},
+		{
+			tag: "image",
+			value: struct {
+				FileName string `validate:"image"`
+			}{FileName: "/nonexistent/image.png"},
+			expected: "Файл, указанный в поле FileName, не существует или не является изображением",
+		},
+		{
+			tag: "dir",
+			value: struct {
+				Value string `validate:"dir"`
+			}{Value: "/nonexistent"},
+			expected: "Каталог, указанный в поле Value, не существует",
+		},
+		{
+			tag: "dirpath",
+			value: struct {
+				Value string `validate:"dirpath"`
+			}{Value: "/tmp/file"},
+			expected: "Поле Value содержит некорректный путь к каталогу",
+		},
+		{
+			tag: "unique",
+			value: struct {
+				Value []string `validate:"unique"`
+			}{Value: []string{"a", "a"}},
+			expected: "Поле Value должно содержать уникальные значения",
+		},
+		{
+			tag: "iscolor",
+			value: struct {
+				Value string `validate:"iscolor"`
+			}{Value: "z"},
+			expected: "Поле Value должно быть цветом",
+		},
+		{
+			tag: "oneof",
+			value: struct {
+				Value string `validate:"oneof=red green"`
+			}{Value: "blue"},
+			expected: "Поле Value должно быть одним из [red green]",
+		},
+		{
+			tag: "oneofci",
+			value: struct {
+				Color string `validate:"oneofci=red green"`
+			}{Color: "blue"},
+			expected: "Поле Color должно быть одним из [red green] без учёта регистра",
+		},
+		{
+			tag: "isdefault",
+			value: struct {
+				Value int `validate:"isdefault"`
+			}{Value: 1},
+			expected: "Поле Value должно иметь значение по умолчанию",
+		},
+		{
+			tag: "field name",
+			value: struct {
+				ParticipantsEmails []string `validate:"min=1"`
+			}{},
+			expected: "Поле ParticipantsEmails должно содержать минимум 1 элемент",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			if got := translateFirst(t, validate, trans, tt.value); got != tt.expected {
+				t.Errorf("got %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRegisterExtraTranslations(t *testing.T) {
+
+	validate := validator.New()
+	trans := NewTranslator()
+
+	if err := RegisterDefaultTranslations(validate, trans); err != nil {
+		t.Fatalf("RegisterDefaultTranslations: %v", err)
+	}
+
+	err := validate.RegisterValidation("starRating", func(fl validator.FieldLevel) bool {
+		return fl.Field().Int() >= 1 && fl.Field().Int() <= 5
+	})
+	if err != nil {
+		t.Fatalf("RegisterValidation: %v", err)
+	}
+
+	err = RegisterExtraTranslations(validate, trans, Catalog{
+		{Tag: "starRating", Translation: "Недопустимая оценка"},
+	})
+	if err != nil {
+		t.Fatalf("RegisterExtraTranslations: %v", err)
+	}
+
+	tests := []struct {
+		tag      string
+		value    interface{}
+		expected string
+	}{
+		{
+			tag: "starRating",
+			value: struct {
+				Rating int `validate:"starRating"`
+			}{Rating: 7},
+			expected: "Недопустимая оценка",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			if got := translateFirst(t, validate, trans, tt.value); got != tt.expected {
+				t.Errorf("got %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+// translateFirst validates s and returns the translation of the only
+// FieldError it produces.
+func translateFirst(t *testing.T, v *validator.Validate, trans ut.Translator, s interface{}) string {
+
+	t.Helper()
+
+	err := v.Struct(s)
+
+	var errs validator.ValidationErrors
+	if !errors.As(err, &errs) || len(errs) != 1 {
+		t.Fatalf("expected a single validation error, got %v", err)
+	}
+
+	return errs[0].Translate(trans)
+}
+
+// newLabelledTranslator returns a validator with the default translations
+// registered, naming the fields of structs after their LabelTag labels and
+// else after names.
+func newLabelledTranslator(t *testing.T, names *FieldDictionary, structs ...interface{}) (*validator.Validate, ut.Translator) {
+
+	t.Helper()
+
+	validate := validator.New()
+	trans := NewTranslator()
+
+	names.AddTagged(LabelTag, structs...)
+
+	if err := RegisterDefaultTranslations(validate, trans, WithFieldNames(names)); err != nil {
+		t.Fatalf("RegisterDefaultTranslations: %v", err)
+	}
+
+	return validate, trans
+}
+
+// expectTranslations validates s and compares the translations of the
+// FieldErrors it produces with expected, keyed by struct namespace.
+func expectTranslations(t *testing.T, v *validator.Validate, trans ut.Translator, s interface{}, expected map[string]string) {
+
+	t.Helper()
+
+	err := v.Struct(s)
+
+	var errs validator.ValidationErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("expected validation errors, got %v", err)
+	}
+
+	if len(errs) != len(expected) {
+		t.Fatalf("got %d errors, want %d", len(errs), len(expected))
+	}
+
+	for _, fe := range errs {
+		if got := fe.Translate(trans); got != expected[fe.StructNamespace()] {
+			t.Errorf("%s: got %q, want %q", fe.StructNamespace(), got, expected[fe.StructNamespace()])
+		}
+	}
+}
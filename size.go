@@ -0,0 +1,136 @@
+package ru
+
+import (
+	"reflect"
+	"strings"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+)
+
+// SizeUnit is the unit a field holding a size is measured in. The length
+// of a []byte is measured in Bytes, an integer holding a file size in
+// kilobytes in Kilobytes. The bounds of len, min and max on such fields are
+// rendered as sizes: "не более 1 МБ" rather than "максимум 1048576
+// элементов".
+type SizeUnit uint8
+
+// Size units. A kilobyte is 1024 bytes, as are the larger units rendered.
+const (
+	SizeUnknown SizeUnit = iota
+	Bytes
+	Kilobytes
+)
+
+// sizeUnitNames maps the size= values of label tags to size units.
+var sizeUnitNames = map[string]SizeUnit{
+	"bytes":     Bytes,
+	"kilobytes": Kilobytes,
+}
+
+// sizeUnitBytes holds the number of bytes in a size unit.
+var sizeUnitBytes = map[SizeUnit]float64{
+	Bytes:     1,
+	Kilobytes: 1 << 10,
+}
+
+// sizeUnits lists the units sizes are rendered in, largest first. Only
+// bytes are spelled out, the abbreviations are not declined.
+var sizeUnits = []struct {
+	size  float64
+	forms map[Case]pluralForms
+}{
+	{
+		size: 1 << 40,
+		forms: map[Case]pluralForms{
+			Accusative: {one: "{0} ТБ", few: "{0} ТБ", many: "{0} ТБ", other: "{0} ТБ"},
+			Genitive:   {one: "{0} ТБ", few: "{0} ТБ", many: "{0} ТБ", other: "{0} ТБ"},
+		},
+	},
+	{
+		size: 1 << 30,
+		forms: map[Case]pluralForms{
+			Accusative: {one: "{0} ГБ", few: "{0} ГБ", many: "{0} ГБ", other: "{0} ГБ"},
+			Genitive:   {one: "{0} ГБ", few: "{0} ГБ", many: "{0} ГБ", other: "{0} ГБ"},
+		},
+	},
+	{
+		size: 1 << 20,
+		forms: map[Case]pluralForms{
+			Accusative: {one: "{0} МБ", few: "{0} МБ", many: "{0} МБ", other: "{0} МБ"},
+			Genitive:   {one: "{0} МБ", few: "{0} МБ", many: "{0} МБ", other: "{0} МБ"},
+		},
+	},
+	{
+		size: 1 << 10,
+		forms: map[Case]pluralForms{
+			Accusative: {one: "{0} КБ", few: "{0} КБ", many: "{0} КБ", other: "{0} КБ"},
+			Genitive:   {one: "{0} КБ", few: "{0} КБ", many: "{0} КБ", other: "{0} КБ"},
+		},
+	},
+	{
+		size: 1,
+		forms: map[Case]pluralForms{
+			Accusative: {one: "{0} байт", few: "{0} байта", many: "{0} байт", other: "{0} байта"},
+			Genitive:   {one: "{0} байта", few: "{0} байт", many: "{0} байт", other: "{0} байта"},
+		},
+	},
+}
+
+// sizeUnit returns the size unit of the field fe was reported for. Strings
+// have none, their length being counted in characters.
+func (o *options) sizeUnit(fe validator.FieldError) (SizeUnit, bool) {
+
+	kind := fe.Kind()
+	if kind == reflect.Ptr {
+		kind = fe.Type().Elem().Kind()
+	}
+
+	if kind == reflect.String {
+		return SizeUnknown, false
+	}
+
+	unit := o.label(fe).Size
+
+	return unit, unit != SizeUnknown
+}
+
+// humanizeSize renders n bytes in case c, which must be Accusative or
+// Genitive, in the largest unit giving at most two fraction digits:
+// "1 МБ", "1,5 КБ", "2 байта".
+func humanizeSize(ut ut.Translator, n float64, c Case) string {
+
+	for _, unit := range sizeUnits {
+
+		v := n / unit.size
+		if v < 1 && unit.size != 1 {
+			continue
+		}
+
+		digits := fractionDigits(v)
+		if digits > 2 && unit.size != 1 {
+			continue
+		}
+
+		return strings.Replace(unit.forms[c].form(ut.CardinalPluralRule(v, digits)), "{0}", formatNumber(v, digits), 1)
+	}
+
+	return formatNumber(n, fractionDigits(n))
+}
+
+// translateSize translates fe reported for a field measured in unit with
+// the translation key, rendering the parameter as a size in case c.
+func (o *options) translateSize(ut ut.Translator, fe validator.FieldError, key string, unit SizeUnit, c Case) string {
+
+	f, _, err := parseNumber(fe.Param())
+	if err != nil {
+		return o.fallback(fe, err)
+	}
+
+	t, err := ut.T(key, o.fieldName(fe), humanizeSize(ut, f*sizeUnitBytes[unit], c))
+	if err != nil {
+		return o.fallback(fe, err)
+	}
+
+	return t
+}
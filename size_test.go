@@ -0,0 +1,65 @@
+package ru
+
+import (
+	"testing"
+)
+
+type upload struct {
+	Name    string `ru:"Имя файла,n,size=bytes" validate:"max=3"`
+	Content []byte `ru:"Содержимое,n,size=bytes" validate:"max=1048576"`
+	Quota   int    `ru:"Квота,f,size=kilobytes" validate:"min=1536"`
+	Chunk   []byte `ru:"Блок,m,size=bytes" validate:"len=2"`
+	Parts   []byte `validate:"max=2"`
+}
+
+func TestHumanizeSize(t *testing.T) {
+
+	trans := NewTranslator()
+
+	tests := []struct {
+		n          float64
+		accusative string
+		genitive   string
+	}{
+		{n: 0, accusative: "0 байт", genitive: "0 байт"},
+		{n: 1, accusative: "1 байт", genitive: "1 байта"},
+		{n: 3, accusative: "3 байта", genitive: "3 байт"},
+		{n: 11, accusative: "11 байт", genitive: "11 байт"},
+		{n: 1000, accusative: "1\u202f000 байт", genitive: "1\u202f000 байт"},
+		{n: 1023, accusative: "1\u202f023 байта", genitive: "1\u202f023 байт"},
+		{n: 1 << 10, accusative: "1 КБ", genitive: "1 КБ"},
+		{n: 1536, accusative: "1,5 КБ", genitive: "1,5 КБ"},
+		{n: 1500, accusative: "1\u202f500 байт", genitive: "1\u202f500 байт"},
+		{n: 1 << 20, accusative: "1 МБ", genitive: "1 МБ"},
+		{n: 5 << 30, accusative: "5 ГБ", genitive: "5 ГБ"},
+		{n: 2048 << 30, accusative: "2 ТБ", genitive: "2 ТБ"},
+	}
+
+	for _, tt := range tests {
+		if got := humanizeSize(trans, tt.n, Accusative); got != tt.accusative {
+			t.Errorf("humanizeSize(%v, Accusative) = %q, want %q", tt.n, got, tt.accusative)
+		}
+		if got := humanizeSize(trans, tt.n, Genitive); got != tt.genitive {
+			t.Errorf("humanizeSize(%v, Genitive) = %q, want %q", tt.n, got, tt.genitive)
+		}
+	}
+}
+
+func TestSizeUnits(t *testing.T) {
+
+	validate, trans := newLabelledTranslator(t, NewFieldDictionary(), upload{})
+
+	expectTranslations(t, validate, trans, upload{
+		Name:    "a.txt",
+		Content: make([]byte, 1<<20+1),
+		Quota:   1,
+		Chunk:   []byte{1},
+		Parts:   []byte{1, 2, 3},
+	}, map[string]string{
+		"upload.Name":    "Поле Имя файла должно содержать максимум 3 символа",
+		"upload.Content": "Поле Содержимое должно быть не более 1 МБ",
+		"upload.Quota":   "Поле Квота должно быть не менее 1,5 МБ",
+		"upload.Chunk":   "Поле Блок должно иметь размер 2 байта",
+		"upload.Parts":   "Поле Parts должно содержать максимум 2 элемента",
+	})
+}
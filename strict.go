@@ -0,0 +1,83 @@
+package ru
+
+import (
+	"errors"
+	"strings"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+)
+
+// TranslationError is returned by TranslateErrors when some FieldErrors
+// could not be translated, either because no translation is registered for
+// their tag or because the translation failed.
+type TranslationError struct {
+	Errors validator.ValidationErrors
+}
+
+func (e *TranslationError) Error() string {
+
+	var b strings.Builder
+
+	b.WriteString("ru: untranslated validation errors:")
+
+	for _, fe := range e.Errors {
+		b.WriteString(" ")
+		b.WriteString(fe.Namespace())
+		b.WriteString(" (")
+		b.WriteString(fe.Tag())
+		b.WriteString(")")
+	}
+
+	return b.String()
+}
+
+// TranslateErrors translates the validator.ValidationErrors in err, keyed by
+// their namespace. FieldErrors that fall back to their English message are
+// still included in the result and reported with a *TranslationError.
+//
+// Errors other than validator.ValidationErrors are returned as is.
+func TranslateErrors(err error, trans ut.Translator) (map[string]string, error) {
+
+	if err == nil {
+		return nil, nil
+	}
+
+	var errs validator.ValidationErrors
+	if !errors.As(err, &errs) {
+		return nil, err
+	}
+
+	messages := make(map[string]string, len(errs))
+
+	var untranslated validator.ValidationErrors
+
+	for _, fe := range errs {
+
+		msg := fe.Translate(trans)
+		if msg == fe.Error() {
+			untranslated = append(untranslated, fe)
+		}
+
+		messages[fe.Namespace()] = msg
+	}
+
+	if len(untranslated) > 0 {
+		return messages, &TranslationError{Errors: untranslated}
+	}
+
+	return messages, nil
+}
+
+// MustTranslateErrors is like TranslateErrors but panics when a FieldError
+// falls back to its English message. It is meant for development and
+// tests, to catch missing translations early.
+func MustTranslateErrors(err error, trans ut.Translator) map[string]string {
+
+	messages, err := TranslateErrors(err, trans)
+	if err != nil {
+		panic(err)
+	}
+
+	return messages
+}
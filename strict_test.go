@@ -0,0 +1,82 @@
+package ru
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+)
+
+func TestTranslateErrors(t *testing.T) {
+
+	validate := validator.New()
+	trans := NewTranslator()
+
+	if err := RegisterDefaultTranslations(validate, trans); err != nil {
+		t.Fatalf("RegisterDefaultTranslations: %v", err)
+	}
+
+	err := validate.RegisterValidation("untranslated", func(validator.FieldLevel) bool { return false })
+	if err != nil {
+		t.Fatalf("RegisterValidation: %v", err)
+	}
+
+	messages, err := TranslateErrors(validate.Struct(job{}), trans)
+
+	var terr *TranslationError
+	if !errors.As(err, &terr) {
+		t.Fatalf("expected a *TranslationError, got %v", err)
+	}
+
+	if len(terr.Errors) != 1 || terr.Errors[0].Namespace() != "job.Code" {
+		t.Errorf("unexpected untranslated errors %v", terr.Errors)
+	}
+
+	if messages["job.Code"] == "" {
+		t.Error("untranslated message is missing from the result")
+	}
+
+	messages, err = TranslateErrors(fmt.Errorf("wrapped: %w", validate.Struct(struct {
+		Login string `validate:"required"`
+	}{})), trans)
+	if err != nil {
+		t.Fatalf("TranslateErrors: %v", err)
+	}
+
+	if got, expected := messages["Login"], "Login обязательное поле"; got != expected {
+		t.Errorf("got %q, want %q", got, expected)
+	}
+
+	if messages, err = TranslateErrors(nil, trans); messages != nil || err != nil {
+		t.Errorf("got %v, %v for a nil error", messages, err)
+	}
+
+	other := errors.New("other")
+	if _, err = TranslateErrors(other, trans); err != other {
+		t.Errorf("got %v, want %v", err, other)
+	}
+}
+
+func TestMustTranslateErrors(t *testing.T) {
+
+	validate := validator.New()
+	trans := NewTranslator()
+
+	if err := RegisterDefaultTranslations(validate, trans); err != nil {
+		t.Fatalf("RegisterDefaultTranslations: %v", err)
+	}
+
+	err := validate.RegisterValidation("untranslated", func(validator.FieldLevel) bool { return false })
+	if err != nil {
+		t.Fatalf("RegisterValidation: %v", err)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic")
+		}
+	}()
+
+	MustTranslateErrors(validate.Var("value", "untranslated"), trans)
+}
@@ -0,0 +1,39 @@
+package ru
+
+import "time"
+
+// DefaultTimeLayout is the layout the bounds of lt, lte, gt and gte on
+// time.Time fields are rendered with unless WithTimeLayout is used.
+const DefaultTimeLayout = "02.01.2006 15:04 MST"
+
+// moscow is the zone the bounds are rendered in unless WithTimeZone is
+// used. Moscow has stayed on UTC+3 since 2014, so a fixed zone avoids
+// depending on the tz database.
+var moscow = time.FixedZone("МСК", 3*60*60)
+
+// now returns the current moment, which validator compares time.Time fields
+// with in lt, lte, gt and gte and the datetime translation renders as the
+// example of its layout; tests replace it.
+var now = time.Now
+
+// WithTimeZone makes the bounds of lt, lte, gt and gte on time.Time fields
+// render in loc, whose abbreviation is shown by the MST element of the
+// layout. Bounds are rendered in Moscow time by default.
+func WithTimeZone(loc *time.Location) Option {
+	return func(o *options) {
+		o.location = loc
+	}
+}
+
+// WithTimeLayout makes the bounds of lt, lte, gt and gte on time.Time
+// fields render with layout instead of DefaultTimeLayout.
+func WithTimeLayout(layout string) Option {
+	return func(o *options) {
+		o.timeLayout = layout
+	}
+}
+
+// formatTime renders t in the configured zone and layout.
+func (o *options) formatTime(t time.Time) string {
+	return t.In(o.location).Format(o.timeLayout)
+}
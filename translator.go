@@ -0,0 +1,18 @@
+package ru
+
+import (
+	russian "github.com/go-playground/locales/ru"
+	ut "github.com/go-playground/universal-translator"
+)
+
+// NewTranslator returns a ut.Translator for the "ru" locale, ready to be
+// passed to RegisterDefaultTranslations.
+func NewTranslator() ut.Translator {
+
+	locale := russian.New()
+	uni := ut.New(locale, locale)
+
+	trans, _ := uni.GetTranslator(locale.Locale())
+
+	return trans
+}